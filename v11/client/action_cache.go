@@ -0,0 +1,265 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+	"github.com/q1bksuu/onebot-go-sdk/v11/server"
+)
+
+const (
+	defaultActionCacheTTL        = time.Minute
+	defaultActionCacheMaxEntries = 1024
+)
+
+const (
+	actionGetGroupMemberInfo = "get_group_member_info"
+	actionGetGroupMemberList = "get_group_member_list"
+	actionGetGroupInfo       = "get_group_info"
+	actionGetGroupList       = "get_group_list"
+	actionGetStrangerInfo    = "get_stranger_info"
+	actionGetFriendList      = "get_friend_list"
+)
+
+// ActionCache 只读信息类动作的 TTL 缓存，通过 Interceptor 接入任意客户端
+// 缓存键为动作名与请求参数（忽略 no_cache），请求设置 no_cache: true 时不读取缓存，而是调用动作并刷新缓存.
+// 缓存条目数超过上限时淘汰最久未使用的条目；通过 EventHandler 接收通知事件，在群成员、管理员、群名片与好友变化时失效相关条目.
+// 缓存的响应属于调用的机器人，多个机器人的客户端应使用各自的 ActionCache.
+type ActionCache struct {
+	options actionCacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type actionCacheOptions struct {
+	ttls       map[string]time.Duration
+	maxEntries int
+	now        func() time.Time
+}
+
+// cacheEntry 缓存条目，groupID 与 userID 取自请求参数，用于按事件失效.
+type cacheEntry struct {
+	key     string
+	action  string
+	groupID int64
+	userID  int64
+	resp    entity.ActionRawResponse
+	expires time.Time
+}
+
+// ActionCacheOption 用于配置 ActionCache 的选项函数类型.
+type ActionCacheOption func(*actionCacheOptions)
+
+// WithCacheTTL 设置动作的缓存时长，ttl 小于等于 0 表示不缓存该动作
+// 默认缓存 get_group_member_info、get_group_info、get_stranger_info 与 get_friend_list，时长 1 分钟.
+func WithCacheTTL(action string, ttl time.Duration) ActionCacheOption {
+	return func(o *actionCacheOptions) { o.ttls[action] = ttl }
+}
+
+// WithCacheMaxEntries 设置缓存条目数上限，默认 1024.
+func WithCacheMaxEntries(maxEntries int) ActionCacheOption {
+	return func(o *actionCacheOptions) { o.maxEntries = maxEntries }
+}
+
+// NewActionCache 创建动作缓存.
+func NewActionCache(opts ...ActionCacheOption) *ActionCache {
+	options := actionCacheOptions{
+		ttls: map[string]time.Duration{
+			actionGetGroupMemberInfo: defaultActionCacheTTL,
+			actionGetGroupInfo:       defaultActionCacheTTL,
+			actionGetStrangerInfo:    defaultActionCacheTTL,
+			actionGetFriendList:      defaultActionCacheTTL,
+		},
+		maxEntries: defaultActionCacheMaxEntries,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &ActionCache{
+		options: options,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Interceptor 返回读写缓存的拦截器，仅缓存成功的响应.
+func (c *ActionCache) Interceptor() Interceptor {
+	return func(ctx context.Context, action string, params any, next Invoker) (*entity.ActionRawResponse, error) {
+		ttl := c.options.ttls[action]
+		if ttl <= 0 {
+			return next(ctx, action, params)
+		}
+
+		values, err := util.JsonStructToMap(params)
+		if err != nil {
+			return next(ctx, action, params)
+		}
+
+		noCache, _ := values["no_cache"].(bool)
+		delete(values, "no_cache")
+
+		// 参数映射按键排序序列化，相同参数得到相同的缓存键
+		encoded, err := json.Marshal(values)
+		if err != nil {
+			return next(ctx, action, params)
+		}
+
+		key := action + "?" + string(encoded)
+
+		if !noCache {
+			if resp, ok := c.get(key); ok {
+				return resp, nil
+			}
+		}
+
+		resp, err := next(ctx, action, params)
+		if err == nil && resp != nil && resp.Status == entity.StatusOK {
+			c.set(&cacheEntry{
+				key:     key,
+				action:  action,
+				groupID: int64Param(values, "group_id"),
+				userID:  int64Param(values, "user_id"),
+				resp:    *resp,
+				expires: c.options.now().Add(ttl),
+			})
+		}
+
+		return resp, err
+	}
+}
+
+// EventHandler 包装事件处理器，在交由 next 处理前按通知事件失效缓存.
+func (c *ActionCache) EventHandler(next server.EventRequestHandler) server.EventRequestHandler {
+	return server.EventRequestHandlerFunc(
+		func(ctx context.Context, event entity.Event) (entity.QuickOperation, error) {
+			c.InvalidateEvent(event)
+
+			return next.HandleEvent(ctx, event)
+		},
+	)
+}
+
+// InvalidateEvent 按通知事件失效相关的缓存条目
+// group_increase、group_decrease、group_admin 与 group_card 失效该群的群信息、成员列表与该成员的信息，
+// 机器人自身入群或退群时同时失效群列表；friend_add 失效好友列表与该用户的信息.
+func (c *ActionCache) InvalidateEvent(event entity.Event) {
+	switch e := event.(type) {
+	case *entity.GroupMemberIncreaseEvent:
+		c.invalidateGroupMember(e.GroupId, e.UserId, e.UserId == e.SelfId)
+	case *entity.GroupMemberDecreaseEvent:
+		kickMe := e.SubType == entity.EventGroupMemberDecreaseSubTypeKickMe
+		c.invalidateGroupMember(e.GroupId, e.UserId, kickMe || e.UserId == e.SelfId)
+	case *entity.GroupAdminChangeEvent:
+		c.invalidateGroupMember(e.GroupId, e.UserId, false)
+	case *entity.GroupCardEvent:
+		c.invalidateGroupMember(e.GroupId, e.UserId, false)
+	case *entity.FriendAddEvent:
+		c.invalidate(func(entry *cacheEntry) bool {
+			return entry.action == actionGetFriendList ||
+				(entry.action == actionGetStrangerInfo && entry.userID == e.UserId)
+		})
+	}
+}
+
+// Len 返回缓存条目数，包含尚未清理的过期条目.
+func (c *ActionCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+// invalidateGroupMember 失效群级别的条目与指定成员的条目，groupList 为 true 时同时失效群列表.
+func (c *ActionCache) invalidateGroupMember(groupID, userID int64, groupList bool) {
+	c.invalidate(func(entry *cacheEntry) bool {
+		if groupList && entry.action == actionGetGroupList {
+			return true
+		}
+
+		switch entry.action {
+		case actionGetGroupInfo, actionGetGroupMemberList:
+			return entry.groupID == groupID
+		case actionGetGroupMemberInfo:
+			return entry.groupID == groupID && entry.userID == userID
+		default:
+			return false
+		}
+	})
+}
+
+func (c *ActionCache) invalidate(match func(entry *cacheEntry) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+
+		entry, _ := elem.Value.(*cacheEntry)
+		if match(entry) {
+			c.remove(elem)
+		}
+
+		elem = next
+	}
+}
+
+func (c *ActionCache) get(key string) (*entity.ActionRawResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry, _ := elem.Value.(*cacheEntry)
+	if !c.options.now().Before(entry.expires) {
+		c.remove(elem)
+
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	// 返回副本，调用方修改响应不影响缓存
+	resp := entry.resp
+
+	return &resp, true
+}
+
+func (c *ActionCache) set(entry *cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[entry.key]; ok {
+		c.remove(elem)
+	}
+
+	c.entries[entry.key] = c.lru.PushFront(entry)
+
+	for c.options.maxEntries > 0 && c.lru.Len() > c.options.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *ActionCache) remove(elem *list.Element) {
+	entry, _ := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+}
+
+// int64Param 读取 JSON 解码后的整数参数，不存在或不是整数时返回 0.
+func int64Param(values map[string]any, key string) int64 {
+	number, _ := values[key].(json.Number)
+	value, _ := number.Int64()
+
+	return value
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/server"
+	"github.com/stretchr/testify/require"
+)
+
+// newCachedActionClient 创建接入缓存的 ActionClient，calls 记录实际调用的动作.
+func newCachedActionClient(t *testing.T, cache *ActionCache) (*ActionClient, *[]string) {
+	t.Helper()
+
+	var calls []string
+
+	handler := dispatcher.ActionRequestHandlerFunc(
+		func(_ context.Context, req *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			calls = append(calls, req.Action)
+
+			if req.Action == "get_stranger_info" {
+				return &entity.ActionRawResponse{Status: entity.StatusFailed, Retcode: entity.RetcodeEmptyResult}, nil
+			}
+
+			if strings.HasSuffix(req.Action, "_list") {
+				return &entity.ActionRawResponse{Status: entity.StatusOK, Data: json.RawMessage(`[]`)}, nil
+			}
+
+			data, err := json.Marshal(req.Params)
+			require.NoError(t, err)
+
+			return &entity.ActionRawResponse{Status: entity.StatusOK, Data: data}, nil
+		},
+	)
+
+	return NewActionClient(handler, WithActionInterceptors(cache.Interceptor())), &calls
+}
+
+func TestActionCache_HitAndExpire(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := NewActionCache(WithCacheTTL("get_group_info", time.Minute))
+	cache.options.now = func() time.Time { return now }
+	client, calls := newCachedActionClient(t, cache)
+	ctx := context.Background()
+
+	for range 2 {
+		resp, err := client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), resp.Data.GroupId)
+	}
+
+	require.Len(t, *calls, 1)
+
+	// 参数不同的请求使用不同的缓存条目
+	_, err := client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 2})
+	require.NoError(t, err)
+	require.Len(t, *calls, 2)
+
+	now = now.Add(time.Minute)
+	_, err = client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1})
+	require.NoError(t, err)
+	require.Len(t, *calls, 3)
+}
+
+func TestActionCache_NoCache(t *testing.T) {
+	t.Parallel()
+
+	client, calls := newCachedActionClient(t, NewActionCache())
+	ctx := context.Background()
+
+	_, err := client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1})
+	require.NoError(t, err)
+
+	// no_cache 跳过缓存并刷新条目，之后的普通请求命中刷新后的条目
+	_, err = client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1, NoCache: true})
+	require.NoError(t, err)
+	_, err = client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1})
+	require.NoError(t, err)
+
+	require.Len(t, *calls, 2)
+}
+
+func TestActionCache_SkipsUncachedActionsAndFailures(t *testing.T) {
+	t.Parallel()
+
+	cache := NewActionCache()
+	client, calls := newCachedActionClient(t, cache)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := client.GetStrangerInfo(ctx, &entity.GetStrangerInfoRequest{UserId: 1})
+		require.ErrorIs(t, err, entity.ErrEmptyResult)
+
+		_, err = client.GetGroupList(ctx, &entity.GetGroupListRequest{})
+		require.NoError(t, err)
+
+		_, err = client.CallAsync(ctx, "get_group_info", &entity.GetGroupInfoRequest{GroupId: 1})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, *calls, 6)
+	require.Zero(t, cache.Len())
+}
+
+func TestActionCache_MaxEntries(t *testing.T) {
+	t.Parallel()
+
+	cache := NewActionCache(WithCacheMaxEntries(2))
+	client, calls := newCachedActionClient(t, cache)
+	ctx := context.Background()
+
+	for _, groupID := range []int64{1, 2, 1, 3, 1, 2} {
+		_, err := client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: groupID})
+		require.NoError(t, err)
+	}
+
+	// 群 2 在加入群 3 时作为最久未使用的条目被淘汰
+	require.Equal(t, 2, cache.Len())
+	require.Len(t, *calls, 4)
+}
+
+func TestActionCache_InvalidateEvent(t *testing.T) {
+	t.Parallel()
+
+	cache := NewActionCache(WithCacheTTL("get_group_list", time.Minute))
+	client, calls := newCachedActionClient(t, cache)
+	ctx := context.Background()
+
+	fill := func() {
+		t.Helper()
+
+		_, err := client.GetGroupMemberInfo(ctx, &entity.GetGroupMemberInfoRequest{GroupId: 1, UserId: 10})
+		require.NoError(t, err)
+		_, err = client.GetGroupMemberInfo(ctx, &entity.GetGroupMemberInfoRequest{GroupId: 1, UserId: 20})
+		require.NoError(t, err)
+		_, err = client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 1})
+		require.NoError(t, err)
+		_, err = client.GetGroupInfo(ctx, &entity.GetGroupInfoRequest{GroupId: 2})
+		require.NoError(t, err)
+		_, err = client.GetFriendList(ctx, &entity.GetFriendListRequest{})
+		require.NoError(t, err)
+	}
+
+	handled := 0
+	handler := cache.EventHandler(server.EventRequestHandlerFunc(
+		func(context.Context, entity.Event) (entity.QuickOperation, error) {
+			handled++
+
+			return nil, nil //nolint:nilnil // 测试处理器不返回快速操作
+		},
+	))
+
+	fill()
+	require.Len(t, *calls, 5)
+
+	// 成员 10 入群：失效群 1 的群信息与该成员的信息
+	_, err := handler.HandleEvent(ctx, mustParseEvent(t, `{"time":1,"self_id":10000,"post_type":"notice",
+		"notice_type":"group_increase","sub_type":"approve","group_id":1,"operator_id":0,"user_id":10}`))
+	require.NoError(t, err)
+	require.Equal(t, 1, handled)
+	require.Equal(t, 3, cache.Len())
+
+	// 设置管理员与新增好友
+	cache.InvalidateEvent(mustParseEvent(t, `{"time":1,"self_id":10000,"post_type":"notice",
+		"notice_type":"group_admin","sub_type":"set","group_id":1,"user_id":20}`))
+	cache.InvalidateEvent(mustParseEvent(t, `{"time":1,"self_id":10000,"post_type":"notice",
+		"notice_type":"friend_add","user_id":30}`))
+	require.Equal(t, 1, cache.Len())
+
+	fill()
+	require.Len(t, *calls, 9)
+
+	// 机器人被移出群 2：失效群 2 的条目与群列表
+	_, err = client.GetGroupList(ctx, &entity.GetGroupListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 6, cache.Len())
+
+	cache.InvalidateEvent(mustParseEvent(t, `{"time":1,"self_id":10000,"post_type":"notice",
+		"notice_type":"group_decrease","sub_type":"kick_me","group_id":2,"operator_id":1,"user_id":10000}`))
+	require.Equal(t, 4, cache.Len())
+}
@@ -0,0 +1,295 @@
+// Code generated by bindings-gen. DO NOT EDIT.
+// Source: cmd/bindings-gen/config.yaml
+
+package client
+
+import (
+	"context"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// API OneBot 11 标准动作接口，各传输层的客户端均实现该接口.
+type API interface {
+	// 消息服务
+
+	// SendPrivateMsg 发送私聊消息.
+	SendPrivateMsg(
+		ctx context.Context,
+		req *entity.SendPrivateMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendPrivateMsgResponse], error)
+
+	// SendGroupMsg 发送群消息.
+	SendGroupMsg(
+		ctx context.Context,
+		req *entity.SendGroupMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendGroupMsgResponse], error)
+
+	// SendMsg 发送消息.
+	SendMsg(
+		ctx context.Context,
+		req *entity.SendMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendMsgResponse], error)
+
+	// DeleteMsg 撤回消息.
+	DeleteMsg(
+		ctx context.Context,
+		req *entity.DeleteMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.DeleteMsgResponse], error)
+
+	// GetMsg 获取消息.
+	GetMsg(
+		ctx context.Context,
+		req *entity.GetMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetMsgResponse], error)
+
+	// GetForwardMsg 获取合并转发消息.
+	GetForwardMsg(
+		ctx context.Context,
+		req *entity.GetForwardMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetForwardMsgResponse], error)
+
+	// 好友与陌生人管理
+
+	// SendLike 发送好友赞.
+	SendLike(
+		ctx context.Context,
+		req *entity.SendLikeRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendLikeResponse], error)
+
+	// SetFriendAddRequest 处理加好友请求.
+	SetFriendAddRequest(
+		ctx context.Context,
+		req *entity.SetFriendAddRequestRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetFriendAddRequestResponse], error)
+
+	// GetStrangerInfo 获取陌生人信息.
+	GetStrangerInfo(
+		ctx context.Context,
+		req *entity.GetStrangerInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetStrangerInfoResponse], error)
+
+	// GetFriendList 获取好友列表.
+	GetFriendList(
+		ctx context.Context,
+		req *entity.GetFriendListRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetFriendListResponse], error)
+
+	// 群管理
+
+	// SetGroupKick 群组踢人.
+	SetGroupKick(
+		ctx context.Context,
+		req *entity.SetGroupKickRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupKickResponse], error)
+
+	// SetGroupBan 群组单人禁言.
+	SetGroupBan(
+		ctx context.Context,
+		req *entity.SetGroupBanRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupBanResponse], error)
+
+	// SetGroupAnonymousBan 群组匿名用户禁言.
+	SetGroupAnonymousBan(
+		ctx context.Context,
+		req *entity.SetGroupAnonymousBanRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupAnonymousBanResponse], error)
+
+	// SetGroupWholeBan 群组全员禁言.
+	SetGroupWholeBan(
+		ctx context.Context,
+		req *entity.SetGroupWholeBanRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupWholeBanResponse], error)
+
+	// SetGroupAdmin 群组设置管理员.
+	SetGroupAdmin(
+		ctx context.Context,
+		req *entity.SetGroupAdminRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupAdminResponse], error)
+
+	// SetGroupAnonymous 群组匿名开关.
+	SetGroupAnonymous(
+		ctx context.Context,
+		req *entity.SetGroupAnonymousRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupAnonymousResponse], error)
+
+	// SetGroupCard 设置群名片.
+	SetGroupCard(
+		ctx context.Context,
+		req *entity.SetGroupCardRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupCardResponse], error)
+
+	// SetGroupName 设置群名.
+	SetGroupName(
+		ctx context.Context,
+		req *entity.SetGroupNameRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupNameResponse], error)
+
+	// SetGroupLeave 退出群组.
+	SetGroupLeave(
+		ctx context.Context,
+		req *entity.SetGroupLeaveRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupLeaveResponse], error)
+
+	// SetGroupSpecialTitle 设置群组专属头衔.
+	SetGroupSpecialTitle(
+		ctx context.Context,
+		req *entity.SetGroupSpecialTitleRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupSpecialTitleResponse], error)
+
+	// SetGroupAddRequest 处理加群请求.
+	SetGroupAddRequest(
+		ctx context.Context,
+		req *entity.SetGroupAddRequestRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetGroupAddRequestResponse], error)
+
+	// 群信息查询
+
+	// GetGroupInfo 获取群信息.
+	GetGroupInfo(
+		ctx context.Context,
+		req *entity.GetGroupInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupInfoResponse], error)
+
+	// GetGroupList 获取群列表.
+	GetGroupList(
+		ctx context.Context,
+		req *entity.GetGroupListRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupListResponse], error)
+
+	// GetGroupMemberInfo 获取群成员信息.
+	GetGroupMemberInfo(
+		ctx context.Context,
+		req *entity.GetGroupMemberInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupMemberInfoResponse], error)
+
+	// GetGroupMemberList 获取群成员列表.
+	GetGroupMemberList(
+		ctx context.Context,
+		req *entity.GetGroupMemberListRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupMemberListResponse], error)
+
+	// GetGroupHonorInfo 获取群荣誉信息.
+	GetGroupHonorInfo(
+		ctx context.Context,
+		req *entity.GetGroupHonorInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupHonorInfoResponse], error)
+
+	// 账号凭证
+
+	// GetLoginInfo 获取登录号信息.
+	GetLoginInfo(
+		ctx context.Context,
+		req *entity.GetLoginInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetLoginInfoResponse], error)
+
+	// GetCookies 获取 cookies.
+	GetCookies(
+		ctx context.Context,
+		req *entity.GetCookiesRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetCookiesResponse], error)
+
+	// GetCsrfToken 获取 csrf token.
+	GetCsrfToken(
+		ctx context.Context,
+		req *entity.GetCsrfTokenRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetCsrfTokenResponse], error)
+
+	// GetCredentials 获取登录凭证.
+	GetCredentials(
+		ctx context.Context,
+		req *entity.GetCredentialsRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetCredentialsResponse], error)
+
+	// 媒体获取
+
+	// GetRecord 获取语音.
+	GetRecord(
+		ctx context.Context,
+		req *entity.GetRecordRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetRecordResponse], error)
+
+	// GetImage 获取图片.
+	GetImage(
+		ctx context.Context,
+		req *entity.GetImageRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetImageResponse], error)
+
+	// 能力检查
+
+	// CanSendImage 检查是否可以发送图片.
+	CanSendImage(
+		ctx context.Context,
+		req *entity.CanSendImageRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.CanSendImageResponse], error)
+
+	// CanSendRecord 检查是否可以发送语音.
+	CanSendRecord(
+		ctx context.Context,
+		req *entity.CanSendRecordRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.CanSendRecordResponse], error)
+
+	// 状态与运维
+
+	// GetStatus 获取运行状态.
+	GetStatus(
+		ctx context.Context,
+		req *entity.GetStatusRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetStatusResponse], error)
+
+	// GetVersionInfo 获取版本信息.
+	GetVersionInfo(
+		ctx context.Context,
+		req *entity.GetVersionInfoRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetVersionInfoResponse], error)
+
+	// SetRestart 重启.
+	SetRestart(
+		ctx context.Context,
+		req *entity.SetRestartRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetRestartResponse], error)
+
+	// CleanCache 清理缓存.
+	CleanCache(
+		ctx context.Context,
+		req *entity.CleanCacheRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.CleanCacheResponse], error)
+}
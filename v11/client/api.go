@@ -0,0 +1,180 @@
+//go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config.yaml -client-api-output=./api.gen.go -action-client-actions-output=./action_client_actions.gen.go
+//go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config_ext.yaml -client-api-output=./api_ext.gen.go -action-client-actions-output=./action_client_ext_actions.gen.go
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// 各传输层客户端均实现 API 与 ExtensionAPI，业务代码依赖接口即可通过配置切换传输层.
+var (
+	_ API          = (*HTTPClient)(nil)
+	_ API          = (*WSActionClient)(nil)
+	_ API          = (*ActionClient)(nil)
+	_ ExtensionAPI = (*HTTPClient)(nil)
+	_ ExtensionAPI = (*WSActionClient)(nil)
+	_ ExtensionAPI = (*ActionClient)(nil)
+	_ AsyncCaller  = (*HTTPClient)(nil)
+	_ AsyncCaller  = (*WSActionClient)(nil)
+	_ AsyncCaller  = (*ActionClient)(nil)
+)
+
+var errAsyncCallOption = errors.New("async call options are only supported by CallAsync")
+
+// AsyncCaller 以 <action>_async 或 <action>_rate_limited 调用动作，OneBot 实现受理后立即返回，不包含执行结果
+// 生成的动作方法只返回包含执行结果的响应，异步调用需通过 CallAsync.
+type AsyncCaller interface {
+	CallAsync(ctx context.Context, action string, req any, opts ...CallOption) (*entity.AsyncResult, error)
+}
+
+// ActionClient 基于任意 dispatcher.ActionRequestHandler 的动作客户端
+// 用于将反向 WebSocket 会话、进程内 Dispatcher（例如测试用的假实现）等适配为 API.
+type ActionClient struct {
+	handler dispatcher.ActionRequestHandler
+	options actionClientOptions
+}
+
+type actionClientOptions struct {
+	limiter      *RateLimiter
+	interceptors []Interceptor
+}
+
+// ActionClientOption 用于配置 ActionClient 的选项函数类型.
+type ActionClientOption func(*actionClientOptions)
+
+// WithActionRateLimiter 设置客户端侧限速器，调用动作前等待许可.
+func WithActionRateLimiter(limiter *RateLimiter) ActionClientOption {
+	return func(o *actionClientOptions) { o.limiter = limiter }
+}
+
+// WithActionInterceptors 追加动作调用拦截器，先添加的拦截器位于外层.
+func WithActionInterceptors(interceptors ...Interceptor) ActionClientOption {
+	return func(o *actionClientOptions) { o.interceptors = append(o.interceptors, interceptors...) }
+}
+
+// NewActionClient 创建基于 handler 的动作客户端.
+func NewActionClient(handler dispatcher.ActionRequestHandler, opts ...ActionClientOption) *ActionClient {
+	var options actionClientOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &ActionClient{handler: handler, options: options}
+}
+
+// HandleActionRequest 将动作请求交由底层 handler 处理，实现 dispatcher.ActionRequestHandler.
+func (c *ActionClient) HandleActionRequest(
+	ctx context.Context, req *entity.ActionRequest,
+) (*entity.ActionRawResponse, error) {
+	resp, err := c.handler.HandleActionRequest(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("handle action %s: %w", req.Action, err)
+	}
+
+	return resp, nil
+}
+
+// CallAsync 以 <action>_async 调用动作，传入 RateLimited 时以 <action>_rate_limited 调用
+// 动作执行失败时返回 *entity.ActionError.
+func (c *ActionClient) CallAsync(
+	ctx context.Context, action string, req any, opts ...CallOption,
+) (*entity.AsyncResult, error) {
+	options := newCallOptions(opts)
+	action = options.asyncAction(action)
+	invoke := chainInterceptors(c.options.interceptors, c.invoke)
+
+	rawResponse, err := invoke(ctx, action, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAsyncResult(action, rawResponse), nil
+}
+
+// do 供生成的动作方法调用，CallOption 中的 HTTP 选项不生效，传入 Async、RateLimited 时返回错误.
+func (c *ActionClient) do(
+	ctx context.Context,
+	action string,
+	_ string,
+	req any,
+	opts ...CallOption,
+) (*entity.ActionRawResponse, error) {
+	options := newCallOptions(opts)
+
+	err := options.checkSync(action)
+	if err != nil {
+		return nil, err
+	}
+
+	invoke := chainInterceptors(c.options.interceptors, c.invoke)
+
+	rawResponse, err := invoke(ctx, action, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkSyncResponse(rawResponse, action)
+}
+
+// invoke 等待限速许可后调用动作.
+func (c *ActionClient) invoke(ctx context.Context, action string, params any) (*entity.ActionRawResponse, error) {
+	err := c.options.limiter.Wait(ctx, action, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return callAction(ctx, c.handler, action, params)
+}
+
+// callAction 将请求结构体编码为动作参数并通过 handler 调用，失败的动作响应转换为错误，参见 checkActionResponse.
+func callAction(
+	ctx context.Context,
+	handler dispatcher.ActionRequestHandler,
+	action string,
+	req any,
+) (*entity.ActionRawResponse, error) {
+	params, err := util.JsonStructToMap(req)
+	if err != nil {
+		return nil, fmt.Errorf("encode request: %w", err)
+	}
+
+	rawResponse, err := handler.HandleActionRequest(ctx, &entity.ActionRequest{Action: action, Params: params})
+	if err != nil {
+		return nil, fmt.Errorf("call %s: %w", action, err)
+	}
+
+	if rawResponse == nil {
+		return nil, fmt.Errorf("%w: empty response for action %s", entity.ErrProtocol, action)
+	}
+
+	err = checkActionResponse(rawResponse, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawResponse, nil
+}
+
+// checkSyncResponse 校验生成的动作方法收到的响应，未使用 _async 等后缀时实现不应异步受理.
+func checkSyncResponse(rawResponse *entity.ActionRawResponse, action string) (*entity.ActionRawResponse, error) {
+	if rawResponse != nil && rawResponse.IsAsync() {
+		return nil, fmt.Errorf("%w: unexpected async response for action %s", entity.ErrProtocol, action)
+	}
+
+	return rawResponse, nil
+}
+
+// newAsyncResult 根据已受理的动作响应构造 *entity.AsyncResult.
+func newAsyncResult(action string, rawResponse *entity.ActionRawResponse) *entity.AsyncResult {
+	return &entity.AsyncResult{
+		Action:  action,
+		Status:  rawResponse.Status,
+		Message: rawResponse.Message,
+	}
+}
@@ -0,0 +1,161 @@
+// Code generated by bindings-gen. DO NOT EDIT.
+// Source: cmd/bindings-gen/config_ext.yaml
+
+package client
+
+import (
+	"context"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// ExtensionAPI OneBot 实现扩展动作接口.
+type ExtensionAPI interface {
+	// 扩展消息服务
+
+	// SendGroupForwardMsg 发送群合并转发消息.
+	SendGroupForwardMsg(
+		ctx context.Context,
+		req *entity.SendGroupForwardMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendGroupForwardMsgResponse], error)
+
+	// SendPrivateForwardMsg 发送私聊合并转发消息.
+	SendPrivateForwardMsg(
+		ctx context.Context,
+		req *entity.SendPrivateForwardMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendPrivateForwardMsgResponse], error)
+
+	// GetGroupMsgHistory 获取群消息历史记录.
+	GetGroupMsgHistory(
+		ctx context.Context,
+		req *entity.GetGroupMsgHistoryRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupMsgHistoryResponse], error)
+
+	// MarkMsgAsRead 标记消息已读.
+	MarkMsgAsRead(
+		ctx context.Context,
+		req *entity.MarkMsgAsReadRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.MarkMsgAsReadResponse], error)
+
+	// SetMsgEmojiLike 对消息贴表情回应.
+	SetMsgEmojiLike(
+		ctx context.Context,
+		req *entity.SetMsgEmojiLikeRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetMsgEmojiLikeResponse], error)
+
+	// 扩展群管理
+
+	// SetEssenceMsg 设置精华消息.
+	SetEssenceMsg(
+		ctx context.Context,
+		req *entity.SetEssenceMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SetEssenceMsgResponse], error)
+
+	// DeleteEssenceMsg 移出精华消息.
+	DeleteEssenceMsg(
+		ctx context.Context,
+		req *entity.DeleteEssenceMsgRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.DeleteEssenceMsgResponse], error)
+
+	// GetEssenceMsgList 获取精华消息列表.
+	GetEssenceMsgList(
+		ctx context.Context,
+		req *entity.GetEssenceMsgListRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetEssenceMsgListResponse], error)
+
+	// SendGroupNotice 发送群公告.
+	SendGroupNotice(
+		ctx context.Context,
+		req *entity.SendGroupNoticeRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.SendGroupNoticeResponse], error)
+
+	// GetGroupAtAllRemain 获取群 @全体成员 剩余次数.
+	GetGroupAtAllRemain(
+		ctx context.Context,
+		req *entity.GetGroupAtAllRemainRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupAtAllRemainResponse], error)
+
+	// 扩展群文件
+
+	// UploadGroupFile 上传群文件.
+	UploadGroupFile(
+		ctx context.Context,
+		req *entity.UploadGroupFileRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.UploadGroupFileResponse], error)
+
+	// UploadPrivateFile 上传私聊文件.
+	UploadPrivateFile(
+		ctx context.Context,
+		req *entity.UploadPrivateFileRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.UploadPrivateFileResponse], error)
+
+	// GetGroupFileUrl 获取群文件资源链接.
+	GetGroupFileUrl(
+		ctx context.Context,
+		req *entity.GetGroupFileUrlRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupFileUrlResponse], error)
+
+	// GetGroupRootFiles 获取群根目录文件列表.
+	GetGroupRootFiles(
+		ctx context.Context,
+		req *entity.GetGroupRootFilesRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupRootFilesResponse], error)
+
+	// GetGroupFilesByFolder 获取群子目录文件列表.
+	GetGroupFilesByFolder(
+		ctx context.Context,
+		req *entity.GetGroupFilesByFolderRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetGroupFilesByFolderResponse], error)
+
+	// DeleteGroupFile 删除群文件.
+	DeleteGroupFile(
+		ctx context.Context,
+		req *entity.DeleteGroupFileRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.DeleteGroupFileResponse], error)
+
+	// CreateGroupFileFolder 创建群文件夹.
+	CreateGroupFileFolder(
+		ctx context.Context,
+		req *entity.CreateGroupFileFolderRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.CreateGroupFileFolderResponse], error)
+
+	// 扩展工具
+
+	// OcrImage 图片 OCR.
+	OcrImage(
+		ctx context.Context,
+		req *entity.OcrImageRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.OcrImageResponse], error)
+
+	// GetOnlineClients 获取当前账号在线客户端列表.
+	GetOnlineClients(
+		ctx context.Context,
+		req *entity.GetOnlineClientsRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.GetOnlineClientsResponse], error)
+
+	// CheckUrlSafely 检查链接安全性.
+	CheckUrlSafely(
+		ctx context.Context,
+		req *entity.CheckUrlSafelyRequest,
+		opts ...CallOption,
+	) (*entity.ActionResponse[entity.CheckUrlSafelyResponse], error)
+}
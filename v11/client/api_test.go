@@ -0,0 +1,200 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	wsinternal "github.com/q1bksuu/onebot-go-sdk/v11/internal/ws"
+	"github.com/stretchr/testify/require"
+)
+
+// newEchoMessageDispatcher 创建处理 send_private_msg 的进程内假实现，消息 ID 为消息文本长度.
+func newEchoMessageDispatcher() *dispatcher.Dispatcher {
+	d := dispatcher.NewDispatcher()
+	d.Register("send_private_msg", dispatcher.APIFuncToActionHandler(
+		func(
+			_ context.Context, req *entity.SendPrivateMsgRequest,
+		) (*entity.ActionResponse[entity.SendPrivateMsgResponse], error) {
+			text, err := req.Message.CQString()
+			if err != nil {
+				return nil, err
+			}
+
+			return &entity.ActionResponse[entity.SendPrivateMsgResponse]{
+				Status: entity.StatusOK,
+				Data:   &entity.SendPrivateMsgResponse{MessageId: req.UserId + int64(len(text))},
+			}, nil
+		},
+	))
+
+	return d
+}
+
+// sendHello 仅依赖 API 接口的业务代码.
+func sendHello(ctx context.Context, api API) (int64, error) {
+	resp, err := api.SendPrivateMsg(ctx, &entity.SendPrivateMsgRequest{
+		UserId:  1000,
+		Message: entity.NewMessage().Text("hello").Build(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Data.MessageId, nil
+}
+
+func TestAPI_Transports(t *testing.T) {
+	t.Parallel()
+
+	d := newEchoMessageDispatcher()
+
+	httpSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]any
+		if json.NewDecoder(r.Body).Decode(&params) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		resp, err := d.HandleActionRequest(r.Context(), &entity.ActionRequest{
+			Action: strings.TrimPrefix(r.URL.Path, "/"),
+			Params: params,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(httpSrv.Close)
+
+	httpClient, err := NewHTTPClient(httpSrv.URL)
+	require.NoError(t, err)
+
+	wsURL := newWSActionServer(t, func(conn *websocket.Conn, r *http.Request) {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			_ = conn.WriteJSON(wsinternal.HandleActionMessage(r.Context(), data, d, nil))
+		}
+	})
+
+	for name, api := range map[string]API{
+		"http":      httpClient,
+		"websocket": startWSActionClient(t, wsURL),
+		"in-memory": NewActionClient(d),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			messageId, err := sendHello(context.Background(), api)
+			require.NoError(t, err)
+			require.Equal(t, int64(1005), messageId)
+		})
+	}
+}
+
+func TestActionClient_Errors(t *testing.T) {
+	t.Parallel()
+
+	errHandler := errors.New("handler failed") //nolint:err113 // mock error for testing
+
+	cases := []struct {
+		name    string
+		handler dispatcher.ActionRequestHandlerFunc
+		wantErr error
+	}{
+		{
+			name: "failed response",
+			handler: func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+				return &entity.ActionRawResponse{Status: entity.StatusFailed, Retcode: entity.RetcodeTooManyRequests}, nil
+			},
+			wantErr: entity.ErrRateLimited,
+		},
+		{
+			name: "handler error",
+			handler: func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+				return nil, errHandler
+			},
+			wantErr: errHandler,
+		},
+		{
+			name: "empty response",
+			handler: func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+				return nil, nil //nolint:nilnil // 模拟实现返回空响应
+			},
+			wantErr: entity.ErrProtocol,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var api ExtensionAPI = NewActionClient(tc.handler)
+
+			_, err := api.GetGroupFileUrl(context.Background(), &entity.GetGroupFileUrlRequest{GroupId: 1})
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestActionClient_HandleActionRequest(t *testing.T) {
+	t.Parallel()
+
+	c := NewActionClient(dispatcher.NewDispatcher())
+
+	_, err := c.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "unknown"})
+	require.ErrorIs(t, err, dispatcher.ErrActionNotFound)
+}
+
+func TestActionClient_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	handler := dispatcher.ActionRequestHandlerFunc(
+		func(_ context.Context, req *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			require.Equal(t, "send_private_msg_rate_limited", req.Action)
+
+			return &entity.ActionRawResponse{Status: entity.StatusAsync, Retcode: entity.RetcodeAsync}, nil
+		},
+	)
+
+	client := NewActionClient(handler)
+
+	result, err := client.CallAsync(
+		context.Background(), "send_private_msg", &entity.SendPrivateMsgRequest{UserId: 1}, RateLimited(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, &entity.AsyncResult{Action: "send_private_msg_rate_limited", Status: entity.StatusAsync}, result)
+
+	// 生成的动作方法只返回包含执行结果的响应，不接受异步调用选项
+	_, err = client.SendPrivateMsg(context.Background(), &entity.SendPrivateMsgRequest{UserId: 1}, RateLimited())
+	require.ErrorIs(t, err, errAsyncCallOption)
+}
+
+func TestActionClient_UnexpectedAsyncResponse(t *testing.T) {
+	t.Parallel()
+
+	handler := dispatcher.ActionRequestHandlerFunc(
+		func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			return &entity.ActionRawResponse{Status: entity.StatusAsync, Retcode: entity.RetcodeAsync}, nil
+		},
+	)
+
+	resp, err := NewActionClient(handler).GetStatus(context.Background(), &entity.GetStatusRequest{})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, entity.ErrProtocol)
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+var errPostURLEmpty = errors.New("post url is empty")
+
+const (
+	defaultEventPostTimeout = 5 * time.Second
+	maxQuickOperationBytes  = 1 << 20
+)
+
+// QuickOperationFunc 执行上报响应中的快速操作，event 为上报的事件.
+// OneBot 实现可通过 server.CallQuickOperation 交由自身的 .handle_quick_operation 动作执行.
+type QuickOperationFunc func(ctx context.Context, event entity.Event, quickOp entity.QuickOperationMap) error
+
+// EventPoster 实现 OneBot HTTP POST 事件上报，供 OneBot 实现或测试工具向机器人应用推送事件
+// 每个事件以 JSON 请求体并发 POST 到所有上报地址，请求携带 X-Self-ID，设置 secret 时携带 X-Signature.
+// 上报地址返回的快速操作交由 QuickOperationFunc 执行.
+type EventPoster struct {
+	urls    []string
+	options eventPosterOptions
+}
+
+type eventPosterOptions struct {
+	httpClient     *http.Client
+	secret         string
+	timeout        time.Duration
+	quickOperation QuickOperationFunc
+}
+
+// EventPosterOption 用于配置 EventPoster 的选项函数类型.
+type EventPosterOption func(*eventPosterOptions)
+
+// WithEventPosterHTTPClient 注入自定义 http.Client.
+func WithEventPosterHTTPClient(hc *http.Client) EventPosterOption {
+	return func(o *eventPosterOptions) { o.httpClient = hc }
+}
+
+// WithEventPosterSecret 设置签名密钥，上报时附加 X-Signature 头.
+func WithEventPosterSecret(secret string) EventPosterOption {
+	return func(o *eventPosterOptions) { o.secret = secret }
+}
+
+// WithEventPosterTimeout 设置单次上报的超时，默认 5 秒，0 表示仅受调用方 ctx 限制.
+func WithEventPosterTimeout(timeout time.Duration) EventPosterOption {
+	return func(o *eventPosterOptions) { o.timeout = timeout }
+}
+
+// WithEventPosterQuickOperation 设置快速操作回调，未设置时忽略上报响应.
+func WithEventPosterQuickOperation(fn QuickOperationFunc) EventPosterOption {
+	return func(o *eventPosterOptions) { o.quickOperation = fn }
+}
+
+// NewEventPoster 创建 HTTP POST 事件上报器.
+func NewEventPoster(urls []string, opts ...EventPosterOption) (*EventPoster, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("%w", errPostURLEmpty)
+	}
+
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse post url: %w", err)
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("parse post url: %w",
+				&url.Error{Op: "parse", URL: rawURL, Err: errMissingSchemeOrHost})
+		}
+	}
+
+	options := eventPosterOptions{timeout: defaultEventPostTimeout}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.httpClient == nil {
+		options.httpClient = &http.Client{}
+	}
+
+	return &EventPoster{
+		urls:    append([]string(nil), urls...),
+		options: options,
+	}, nil
+}
+
+// Post 将事件上报到所有地址并执行返回的快速操作，返回各地址上报失败的错误.
+func (p *EventPoster) Post(ctx context.Context, event entity.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
+	}
+
+	errs := make([]error, len(p.urls))
+
+	var wg sync.WaitGroup
+
+	for i, target := range p.urls {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[i] = p.postTo(ctx, target, event, body)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (p *EventPoster) postTo(ctx context.Context, target string, event entity.Event, body []byte) error {
+	quickOp, err := p.send(ctx, target, event.GetSelfId(), body)
+	if err != nil {
+		return err
+	}
+
+	if len(quickOp) == 0 || p.options.quickOperation == nil {
+		return nil
+	}
+
+	err = p.options.quickOperation(ctx, event, quickOp)
+	if err != nil {
+		return fmt.Errorf("quick operation from %s: %w", target, err)
+	}
+
+	return nil
+}
+
+// send 发送上报请求并解析响应中的快速操作，响应为空时返回 nil.
+func (p *EventPoster) send(
+	ctx context.Context, target string, selfID int64, body []byte,
+) (entity.QuickOperationMap, error) {
+	if p.options.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, p.options.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Self-ID", strconv.FormatInt(selfID, 10))
+
+	if p.options.secret != "" {
+		req.Header.Set(util.SignatureHeader, util.SignBody(p.options.secret, body))
+	}
+
+	resp, err := p.options.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: post event: %w", entity.ErrNetwork, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+
+		return nil, &entity.HTTPStatusError{
+			UrlPath:    target,
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(b)),
+		}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxQuickOperationBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: read quick operation: %w", entity.ErrNetwork, err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil //nolint:nilnil // 没有快速操作
+	}
+
+	var quickOp entity.QuickOperationMap
+
+	err = json.Unmarshal(data, &quickOp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode quick operation from %s: %w", entity.ErrProtocol, target, err)
+	}
+
+	return quickOp, nil
+}
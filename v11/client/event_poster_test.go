@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+	"github.com/q1bksuu/onebot-go-sdk/v11/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventPoster_Validate(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewEventPoster(nil)
+	require.ErrorIs(t, err, errPostURLEmpty)
+
+	_, err = NewEventPoster([]string{"/event"})
+	require.ErrorIs(t, err, errMissingSchemeOrHost)
+}
+
+func TestEventPoster_HeadersAndSignature(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+
+	requests := make(chan request, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- request{header: r.Header.Clone(), body: body}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ts.Close)
+
+	poster, err := NewEventPoster([]string{ts.URL}, WithEventPosterSecret("secret"))
+	require.NoError(t, err)
+	require.NoError(t, poster.Post(context.Background(), mustParseEvent(t, testPrivateMessageEvent)))
+
+	req := <-requests
+	require.Equal(t, "10000", req.header.Get("X-Self-ID"))
+	require.Equal(t, "application/json", req.header.Get("Content-Type"))
+	require.Equal(t, util.SignBody("secret", req.body), req.header.Get("X-Signature"))
+	require.Contains(t, string(req.body), `"post_type":"message"`)
+}
+
+func TestEventPoster_QuickOperation(t *testing.T) {
+	t.Parallel()
+
+	srv := server.NewHTTPServer(
+		server.WithEventPath("/event"),
+		server.WithSecret("secret"),
+		server.WithEventHandler(server.EventRequestHandlerFunc(
+			func(context.Context, entity.Event) (entity.QuickOperation, error) {
+				return &entity.MessageQuickOperation{Reply: entity.NewMessage().Text("pong").Build()}, nil
+			},
+		)),
+	)
+	ts := httptest.NewServer(srv.Handler())
+	t.Cleanup(ts.Close)
+
+	quickOps := make(chan entity.QuickOperationMap, 2)
+	poster, err := NewEventPoster(
+		[]string{ts.URL + "/event", ts.URL + "/event"},
+		WithEventPosterSecret("secret"),
+		WithEventPosterQuickOperation(func(_ context.Context, _ entity.Event, quickOp entity.QuickOperationMap) error {
+			quickOps <- quickOp
+
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, poster.Post(context.Background(), mustParseEvent(t, testPrivateMessageEvent)))
+
+	// 每个上报地址返回的快速操作都交由回调执行
+	for range 2 {
+		quickOp := <-quickOps
+		require.Equal(t, []any{map[string]any{"type": "text", "data": map[string]any{"text": "pong"}}}, quickOp["reply"])
+	}
+}
+
+func TestEventPoster_Errors(t *testing.T) {
+	t.Parallel()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	t.Cleanup(failing.Close)
+
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(slow.Close)
+	t.Cleanup(func() { close(release) })
+
+	invalid := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("not json"))
+	}))
+	t.Cleanup(invalid.Close)
+
+	poster, err := NewEventPoster(
+		[]string{failing.URL, slow.URL, invalid.URL},
+		WithEventPosterTimeout(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	err = poster.Post(context.Background(), mustParseEvent(t, testPrivateMessageEvent))
+
+	var statusErr *entity.HTTPStatusError
+
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+	require.Equal(t, "boom", statusErr.Body)
+	require.ErrorIs(t, err, entity.ErrNetwork)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, err, entity.ErrProtocol)
+}
@@ -1,4 +1,5 @@
 //go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config.yaml -http-client-actions-output=./http_client_actions.gen.go
+//go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config_ext.yaml -http-client-actions-output=./http_client_ext_actions.gen.go
 package client
 
 import (
@@ -20,23 +21,28 @@ import (
 var (
 	errBaseURLEmpty          = errors.New("baseURL is empty")
 	errUnsupportedHTTPMethod = errors.New("unsupported http method")
-	errHTTPStatus            = errors.New("unexpected http status")
 	errMissingSchemeOrHost   = errors.New("missing scheme or host")
 )
 
 const maxErrorBodyBytes = 1024
 
 type HTTPClient struct {
-	baseURL     string
-	accessToken string
-	httpClient  *http.Client
+	baseURL      string
+	accessToken  string
+	httpClient   *http.Client
+	retry        RetryPolicy
+	limiter      *RateLimiter
+	interceptors []Interceptor
 }
 
 type clientOptions struct {
-	httpClient  *http.Client
-	accessToken string
-	pathPrefix  string
-	timeout     time.Duration
+	httpClient   *http.Client
+	accessToken  string
+	pathPrefix   string
+	timeout      time.Duration
+	retry        RetryPolicy
+	limiter      *RateLimiter
+	interceptors []Interceptor
 }
 
 // NewHTTPClient 创建 HTTP 客户端封装.
@@ -56,12 +62,31 @@ func NewHTTPClient(baseURL string, opts ...Option) (*HTTPClient, error) {
 	}
 
 	return &HTTPClient{
-		baseURL:     strings.TrimRight(baseURL, "/"),
-		accessToken: options.accessToken,
-		httpClient:  httpClient,
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		accessToken:  options.accessToken,
+		httpClient:   httpClient,
+		retry:        options.retry,
+		limiter:      options.limiter,
+		interceptors: options.interceptors,
 	}, nil
 }
 
+// CallAsync 以 POST 调用 <action>_async，传入 RateLimited 时调用 <action>_rate_limited
+// 动作执行失败时返回 *entity.ActionError.
+func (c *HTTPClient) CallAsync(
+	ctx context.Context, action string, req any, opts ...CallOption,
+) (*entity.AsyncResult, error) {
+	options := newCallOptions(opts)
+	action = options.asyncAction(action)
+
+	rawResponse, err := c.call(ctx, action, http.MethodPost, req, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAsyncResult(action, rawResponse), nil
+}
+
 func (c *HTTPClient) do(
 	ctx context.Context,
 	urlPath string,
@@ -69,11 +94,76 @@ func (c *HTTPClient) do(
 	req any,
 	opts ...CallOption,
 ) (*entity.ActionRawResponse, error) {
-	options := callOptions{}
-	for _, opt := range opts {
-		opt(&options)
+	options := newCallOptions(opts)
+
+	err := options.checkSync(urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rawResponse, err := c.call(ctx, urlPath, defaultMethod, req, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkSyncResponse(rawResponse, urlPath)
+}
+
+// call 经过拦截器链调用动作.
+func (c *HTTPClient) call(
+	ctx context.Context,
+	urlPath string,
+	defaultMethod string,
+	req any,
+	options *callOptions,
+) (*entity.ActionRawResponse, error) {
+	invoke := chainInterceptors(c.interceptors,
+		func(ctx context.Context, action string, params any) (*entity.ActionRawResponse, error) {
+			return c.invoke(ctx, action, defaultMethod, params, options)
+		},
+	)
+
+	return invoke(ctx, urlPath, req)
+}
+
+// invoke 按重试策略发送动作请求，每次尝试前等待限速许可.
+func (c *HTTPClient) invoke(
+	ctx context.Context,
+	action string,
+	defaultMethod string,
+	req any,
+	options *callOptions,
+) (*entity.ActionRawResponse, error) {
+	attempts := c.retry.attempts(action, options.retry)
+
+	for attempt := 1; ; attempt++ {
+		err := c.limiter.Wait(ctx, action, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.doOnce(ctx, action, defaultMethod, req, options)
+		if err == nil || attempt >= attempts || ctx.Err() != nil || !c.retry.retryable(err) {
+			return resp, err
+		}
+
+		// 等待退避间隔，ctx 取消时返回最后一次的错误
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(c.retry.backoff(attempt)):
+		}
 	}
+}
 
+// doOnce 发送一次动作请求.
+func (c *HTTPClient) doOnce(
+	ctx context.Context,
+	urlPath string,
+	defaultMethod string,
+	req any,
+	options *callOptions,
+) (*entity.ActionRawResponse, error) {
 	method := resolveMethod(defaultMethod, options.methodOverride)
 
 	err := validateMethod(method)
@@ -105,7 +195,7 @@ func (c *HTTPClient) do(
 
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("do request: %w", err)
+		return nil, fmt.Errorf("%w: do request: %w", entity.ErrNetwork, err)
 	}
 
 	defer func() {
@@ -212,18 +302,36 @@ func parseActionResponse(resp *http.Response, urlPath string) (*entity.ActionRaw
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
 
-		return nil, fmt.Errorf("%w: %d %s", errHTTPStatus, resp.StatusCode, strings.TrimSpace(string(b)))
+		return nil, &entity.HTTPStatusError{
+			UrlPath:    urlPath,
+			StatusCode: resp.StatusCode,
+			Body:       strings.TrimSpace(string(b)),
+		}
 	}
 
 	var rawResponse entity.ActionRawResponse
 
 	err := json.NewDecoder(resp.Body).Decode(&rawResponse)
 	if err != nil {
-		return nil, fmt.Errorf("decode action response: %w", err)
+		return nil, fmt.Errorf("%w: decode action response: %w", entity.ErrProtocol, err)
+	}
+
+	err = checkActionResponse(&rawResponse, urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rawResponse, nil
+}
+
+// checkActionResponse 将失败的动作响应转换为 *entity.ActionError，异步受理的响应不是错误，原样返回给调用方.
+func checkActionResponse(rawResponse *entity.ActionRawResponse, urlPath string) error {
+	if rawResponse.IsAsync() {
+		return nil
 	}
 
 	if rawResponse.Status == entity.StatusFailed || rawResponse.Retcode != 0 {
-		return nil, &entity.ActionError{
+		return &entity.ActionError{
 			UrlPath: urlPath,
 			Status:  rawResponse.Status,
 			Retcode: rawResponse.Retcode,
@@ -231,7 +339,7 @@ func parseActionResponse(resp *http.Response, urlPath string) (*entity.ActionRaw
 		}
 	}
 
-	return &rawResponse, nil
+	return nil
 }
 
 func encodeToParams(req any) (map[string]any, error) {
@@ -0,0 +1,471 @@
+// Code generated by bindings-gen. DO NOT EDIT.
+// Source: cmd/bindings-gen/config_ext.yaml
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// SendGroupForwardMsg calls action "send_group_forward_msg".
+func (c *HTTPClient) SendGroupForwardMsg(
+	ctx context.Context,
+	req *entity.SendGroupForwardMsgRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.SendGroupForwardMsgResponse], error) {
+	rawResponse, err := c.do(ctx, "send_group_forward_msg", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.SendGroupForwardMsgResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendPrivateForwardMsg calls action "send_private_forward_msg".
+func (c *HTTPClient) SendPrivateForwardMsg(
+	ctx context.Context,
+	req *entity.SendPrivateForwardMsgRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.SendPrivateForwardMsgResponse], error) {
+	rawResponse, err := c.do(ctx, "send_private_forward_msg", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.SendPrivateForwardMsgResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroupMsgHistory calls action "get_group_msg_history".
+func (c *HTTPClient) GetGroupMsgHistory(
+	ctx context.Context,
+	req *entity.GetGroupMsgHistoryRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetGroupMsgHistoryResponse], error) {
+	rawResponse, err := c.do(ctx, "get_group_msg_history", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetGroupMsgHistoryResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MarkMsgAsRead calls action "mark_msg_as_read".
+func (c *HTTPClient) MarkMsgAsRead(
+	ctx context.Context,
+	req *entity.MarkMsgAsReadRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.MarkMsgAsReadResponse], error) {
+	rawResponse, err := c.do(ctx, "mark_msg_as_read", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.MarkMsgAsReadResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetMsgEmojiLike calls action "set_msg_emoji_like".
+func (c *HTTPClient) SetMsgEmojiLike(
+	ctx context.Context,
+	req *entity.SetMsgEmojiLikeRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.SetMsgEmojiLikeResponse], error) {
+	rawResponse, err := c.do(ctx, "set_msg_emoji_like", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.SetMsgEmojiLikeResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetEssenceMsg calls action "set_essence_msg".
+func (c *HTTPClient) SetEssenceMsg(
+	ctx context.Context,
+	req *entity.SetEssenceMsgRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.SetEssenceMsgResponse], error) {
+	rawResponse, err := c.do(ctx, "set_essence_msg", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.SetEssenceMsgResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEssenceMsg calls action "delete_essence_msg".
+func (c *HTTPClient) DeleteEssenceMsg(
+	ctx context.Context,
+	req *entity.DeleteEssenceMsgRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.DeleteEssenceMsgResponse], error) {
+	rawResponse, err := c.do(ctx, "delete_essence_msg", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.DeleteEssenceMsgResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEssenceMsgList calls action "get_essence_msg_list".
+func (c *HTTPClient) GetEssenceMsgList(
+	ctx context.Context,
+	req *entity.GetEssenceMsgListRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetEssenceMsgListResponse], error) {
+	rawResponse, err := c.do(ctx, "get_essence_msg_list", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetEssenceMsgListResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendGroupNotice calls action "_send_group_notice".
+func (c *HTTPClient) SendGroupNotice(
+	ctx context.Context,
+	req *entity.SendGroupNoticeRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.SendGroupNoticeResponse], error) {
+	rawResponse, err := c.do(ctx, "_send_group_notice", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.SendGroupNoticeResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroupAtAllRemain calls action "get_group_at_all_remain".
+func (c *HTTPClient) GetGroupAtAllRemain(
+	ctx context.Context,
+	req *entity.GetGroupAtAllRemainRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetGroupAtAllRemainResponse], error) {
+	rawResponse, err := c.do(ctx, "get_group_at_all_remain", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetGroupAtAllRemainResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadGroupFile calls action "upload_group_file".
+func (c *HTTPClient) UploadGroupFile(
+	ctx context.Context,
+	req *entity.UploadGroupFileRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.UploadGroupFileResponse], error) {
+	rawResponse, err := c.do(ctx, "upload_group_file", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.UploadGroupFileResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadPrivateFile calls action "upload_private_file".
+func (c *HTTPClient) UploadPrivateFile(
+	ctx context.Context,
+	req *entity.UploadPrivateFileRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.UploadPrivateFileResponse], error) {
+	rawResponse, err := c.do(ctx, "upload_private_file", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.UploadPrivateFileResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroupFileUrl calls action "get_group_file_url".
+func (c *HTTPClient) GetGroupFileUrl(
+	ctx context.Context,
+	req *entity.GetGroupFileUrlRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetGroupFileUrlResponse], error) {
+	rawResponse, err := c.do(ctx, "get_group_file_url", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetGroupFileUrlResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroupRootFiles calls action "get_group_root_files".
+func (c *HTTPClient) GetGroupRootFiles(
+	ctx context.Context,
+	req *entity.GetGroupRootFilesRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetGroupRootFilesResponse], error) {
+	rawResponse, err := c.do(ctx, "get_group_root_files", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetGroupRootFilesResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroupFilesByFolder calls action "get_group_files_by_folder".
+func (c *HTTPClient) GetGroupFilesByFolder(
+	ctx context.Context,
+	req *entity.GetGroupFilesByFolderRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetGroupFilesByFolderResponse], error) {
+	rawResponse, err := c.do(ctx, "get_group_files_by_folder", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetGroupFilesByFolderResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGroupFile calls action "delete_group_file".
+func (c *HTTPClient) DeleteGroupFile(
+	ctx context.Context,
+	req *entity.DeleteGroupFileRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.DeleteGroupFileResponse], error) {
+	rawResponse, err := c.do(ctx, "delete_group_file", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.DeleteGroupFileResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGroupFileFolder calls action "create_group_file_folder".
+func (c *HTTPClient) CreateGroupFileFolder(
+	ctx context.Context,
+	req *entity.CreateGroupFileFolderRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.CreateGroupFileFolderResponse], error) {
+	rawResponse, err := c.do(ctx, "create_group_file_folder", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.CreateGroupFileFolderResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OcrImage calls action "ocr_image".
+func (c *HTTPClient) OcrImage(
+	ctx context.Context,
+	req *entity.OcrImageRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.OcrImageResponse], error) {
+	rawResponse, err := c.do(ctx, "ocr_image", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.OcrImageResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOnlineClients calls action "get_online_clients".
+func (c *HTTPClient) GetOnlineClients(
+	ctx context.Context,
+	req *entity.GetOnlineClientsRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.GetOnlineClientsResponse], error) {
+	rawResponse, err := c.do(ctx, "get_online_clients", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.GetOnlineClientsResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckUrlSafely calls action "check_url_safely".
+func (c *HTTPClient) CheckUrlSafely(
+	ctx context.Context,
+	req *entity.CheckUrlSafelyRequest,
+	opts ...CallOption,
+) (*entity.ActionResponse[entity.CheckUrlSafelyResponse], error) {
+	rawResponse, err := c.do(ctx, "check_url_safely", "POST", req, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := entity.ActionResponse[entity.CheckUrlSafelyResponse]{
+		Status:  rawResponse.Status,
+		Retcode: rawResponse.Retcode,
+		Message: rawResponse.Message,
+	}
+	err = json.Unmarshal(rawResponse.GetData(), &out.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
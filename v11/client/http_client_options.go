@@ -1,6 +1,7 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -29,12 +30,72 @@ func WithTimeout(d time.Duration) Option {
 	return func(o *clientOptions) { o.timeout = d }
 }
 
+// WithRetryPolicy 设置动作调用的重试策略，默认不重试.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *clientOptions) { o.retry = policy }
+}
+
+// WithRateLimiter 设置客户端侧限速器，调用动作前等待许可.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(o *clientOptions) { o.limiter = limiter }
+}
+
+// WithInterceptors 追加动作调用拦截器，先添加的拦截器位于外层.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(o *clientOptions) { o.interceptors = append(o.interceptors, interceptors...) }
+}
+
 type CallOption func(*callOptions)
 
 type callOptions struct {
 	headers        http.Header
 	query          url.Values
 	methodOverride string
+	retry          bool
+	actionSuffix   string
+}
+
+const (
+	asyncActionSuffix       = "_async"
+	rateLimitedActionSuffix = "_rate_limited"
+)
+
+func newCallOptions(opts []CallOption) callOptions {
+	options := callOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// checkSync 校验生成的动作方法的调用选项，Async、RateLimited 仅对 CallAsync 生效.
+func (co *callOptions) checkSync(action string) error {
+	if co.actionSuffix != "" {
+		return fmt.Errorf("%w: %s", errAsyncCallOption, action)
+	}
+
+	return nil
+}
+
+// asyncAction 返回 CallAsync 实际调用的动作名，未设置后缀时使用 _async.
+func (co *callOptions) asyncAction(action string) string {
+	if co.actionSuffix == "" {
+		return action + asyncActionSuffix
+	}
+
+	return action + co.actionSuffix
+}
+
+// baseAction 去除动作名中的 _async、_rate_limited 后缀.
+func baseAction(action string) string {
+	for _, suffix := range [...]string{asyncActionSuffix, rateLimitedActionSuffix} {
+		if trimmed, ok := strings.CutSuffix(action, suffix); ok {
+			return trimmed
+		}
+	}
+
+	return action
 }
 
 // WithHeader 为单次调用追加自定义 Header.
@@ -65,3 +126,25 @@ func WithMethod(method string) CallOption {
 		co.methodOverride = strings.ToUpper(strings.TrimSpace(method))
 	}
 }
+
+// WithRetry 允许单次调用按重试策略重试，用于 send_*、set_* 等有副作用的动作.
+// 只读动作默认按重试策略重试，无需设置.
+func WithRetry() CallOption {
+	return func(co *callOptions) {
+		co.retry = true
+	}
+}
+
+// Async 以 <action>_async 异步调用动作，仅对 CallAsync 生效（CallAsync 的默认行为）.
+func Async() CallOption {
+	return func(co *callOptions) {
+		co.actionSuffix = asyncActionSuffix
+	}
+}
+
+// RateLimited 以 <action>_rate_limited 限速调用动作，仅对 CallAsync 生效，OneBot 实现按限速队列执行.
+func RateLimited() CallOption {
+	return func(co *callOptions) {
+		co.actionSuffix = rateLimitedActionSuffix
+	}
+}
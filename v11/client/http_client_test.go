@@ -113,7 +113,27 @@ func TestHTTPClient_do_StatusNot2xx(t *testing.T) {
 
 	_, err := client.do(context.Background(), "/err", http.MethodGet, struct{}{})
 	require.Error(t, err)
-	require.ErrorContains(t, err, errHTTPStatus.Error()+": 500")
+	require.ErrorContains(t, err, entity.ErrHTTPStatus.Error()+": 500")
+	require.ErrorIs(t, err, entity.ErrHTTPStatus)
+	require.ErrorIs(t, err, entity.ErrInternalError)
+	require.False(t, entity.IsActionFailed(err))
+
+	var statusErr *entity.HTTPStatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+	require.Equal(t, "oops", statusErr.Body)
+}
+
+func TestHTTPClient_do_NetworkError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(_ *http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	})
+
+	_, err := client.do(context.Background(), "/net", http.MethodGet, struct{}{})
+	require.ErrorIs(t, err, entity.ErrNetwork)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
 }
 
 func TestHTTPClient_do_NonZeroRetcode(t *testing.T) {
@@ -130,6 +150,54 @@ func TestHTTPClient_do_NonZeroRetcode(t *testing.T) {
 	require.ErrorAs(t, err, &ae)
 	require.Equal(t, entity.ActionResponseRetcode(114), ae.Retcode)
 	require.Equal(t, "bad", ae.Message)
+	require.True(t, entity.IsActionFailed(err))
+}
+
+func TestHTTPClient_ActionSuffix_Async(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     []CallOption
+		wantPath string
+	}{
+		{name: "default", wantPath: "/send_msg_async"},
+		{name: "async", opts: []CallOption{Async()}, wantPath: "/send_msg_async"},
+		{name: "rate limited", opts: []CallOption{RateLimited()}, wantPath: "/send_msg_rate_limited"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, tc.wantPath, r.URL.Path)
+
+				return jsonRespOk(`{"status":"async","retcode":1,"data":null}`), nil
+			})
+
+			result, err := client.CallAsync(context.Background(), "send_msg", &entity.SendMsgRequest{UserId: 1}, tc.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPath[1:], result.Action)
+			require.Equal(t, entity.StatusAsync, result.Status)
+		})
+	}
+}
+
+func TestHTTPClient_CallAsync_Failed(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(_ *http.Request) (*http.Response, error) {
+		return jsonRespOk(`{"status":"failed","retcode":1400,"message":"bad"}`), nil
+	})
+
+	result, err := client.CallAsync(context.Background(), "send_msg", &entity.SendMsgRequest{UserId: 1})
+	require.Nil(t, result)
+	require.ErrorIs(t, err, entity.ErrBadRequest)
+
+	_, err = client.SendMsg(context.Background(), &entity.SendMsgRequest{UserId: 1}, Async())
+	require.ErrorIs(t, err, errAsyncCallOption)
 }
 
 func TestHTTPClient_do_DecodeError(t *testing.T) {
@@ -147,6 +215,7 @@ func TestHTTPClient_do_DecodeError(t *testing.T) {
 	_, err := client.do(context.Background(), "/bad-json", http.MethodGet, struct{}{})
 	require.Error(t, err)
 	require.ErrorContains(t, err, "decode action response")
+	require.ErrorIs(t, err, entity.ErrProtocol)
 }
 
 func TestHTTPClient_SendPrivateMsg_Success(t *testing.T) {
@@ -168,6 +237,26 @@ func TestHTTPClient_SendPrivateMsg_Success(t *testing.T) {
 	require.Equal(t, int64(321), resp.Data.MessageId)
 }
 
+func TestHTTPClient_ExtensionAction_Success(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, "/get_group_file_url", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "/abc", body["file_id"])
+
+		return jsonRespOk(`{"status":"ok","retcode":0,"data":{"url":"https://example/a"}}`), nil
+	})
+
+	resp, err := client.GetGroupFileUrl(context.Background(), &entity.GetGroupFileUrlRequest{
+		GroupId: 1, FileId: "/abc", Busid: 102,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example/a", resp.Data.GetUrl())
+}
+
 func TestHTTPClientOptions_PathPrefixAndTimeout(t *testing.T) {
 	t.Parallel()
 
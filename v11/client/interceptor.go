@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// Invoker 执行一次动作调用，action 为实际调用的动作名（含 _async 等后缀），params 为请求结构体.
+type Invoker func(ctx context.Context, action string, params any) (*entity.ActionRawResponse, error)
+
+// Interceptor 包裹动作调用的拦截器，可用于日志、指标、链路追踪、修改请求或模拟响应
+// 调用 next 继续执行后续拦截器与实际调用，不调用 next 时直接以返回值作为调用结果.
+// 拦截器作用于所有生成的动作方法，HTTPClient、WSActionClient 与 ActionClient 使用相同的拦截器类型.
+type Interceptor func(
+	ctx context.Context, action string, params any, next Invoker,
+) (*entity.ActionRawResponse, error)
+
+// chainInterceptors 将拦截器依次包裹在 invoker 外，第一个拦截器位于最外层.
+func chainInterceptors(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, action string, params any) (*entity.ActionRawResponse, error) {
+			return interceptor(ctx, action, params, next)
+		}
+	}
+
+	return invoker
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLoginInfo 不调用 next，直接返回 get_login_info 的模拟响应.
+func mockLoginInfo(_ context.Context, action string, _ any, _ Invoker) (*entity.ActionRawResponse, error) {
+	if action != "get_login_info" {
+		return nil, fmt.Errorf("%w: %s", entity.ErrNotFound, action)
+	}
+
+	return &entity.ActionRawResponse{Status: entity.StatusOK, Data: json.RawMessage(`{"user_id":42}`)}, nil
+}
+
+func TestChainInterceptors_Order(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, action string, params any, next Invoker) (*entity.ActionRawResponse, error) {
+			calls = append(calls, name+" before")
+			resp, err := next(ctx, action, params)
+			calls = append(calls, name+" after")
+
+			return resp, err
+		}
+	}
+
+	invoke := chainInterceptors([]Interceptor{record("a"), record("b")},
+		func(context.Context, string, any) (*entity.ActionRawResponse, error) {
+			calls = append(calls, "invoke")
+
+			return &entity.ActionRawResponse{}, nil
+		},
+	)
+
+	_, err := invoke(context.Background(), "get_status", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a before", "b before", "invoke", "b after", "a after"}, calls)
+}
+
+func TestHTTPClient_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+
+	client := newTestClient(t, func(r *http.Request) (*http.Response, error) {
+		require.Equal(t, "/send_private_msg_async", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.InEpsilon(t, float64(2), body["user_id"], 1e-9)
+
+		return jsonRespOk(`{"status":"ok","retcode":0,"data":{"message_id":1}}`), nil
+	}, WithInterceptors(
+		func(ctx context.Context, action string, params any, next Invoker) (*entity.ActionRawResponse, error) {
+			seen = append(seen, action)
+
+			return next(ctx, action, params)
+		},
+		// 修改请求参数
+		func(ctx context.Context, action string, params any, next Invoker) (*entity.ActionRawResponse, error) {
+			if req, ok := params.(*entity.SendPrivateMsgRequest); ok {
+				req.UserId = 2
+			}
+
+			return next(ctx, action, params)
+		},
+	))
+
+	_, err := client.CallAsync(context.Background(), "send_private_msg", &entity.SendPrivateMsgRequest{UserId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"send_private_msg_async"}, seen)
+}
+
+func TestInterceptors_MockTransports(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newTestClient(t, func(*http.Request) (*http.Response, error) {
+		t.Error("transport should not be called")
+
+		return nil, io.ErrUnexpectedEOF
+	}, WithInterceptors(mockLoginInfo))
+
+	wsClient, err := NewWSActionClient("ws://127.0.0.1:0", WithWSActionInterceptors(mockLoginInfo))
+	require.NoError(t, err)
+
+	actionClient := NewActionClient(dispatcher.NewDispatcher(), WithActionInterceptors(mockLoginInfo))
+
+	for _, api := range []API{httpClient, wsClient, actionClient} {
+		resp, err := api.GetLoginInfo(context.Background(), &entity.GetLoginInfoRequest{})
+		require.NoError(t, err)
+		require.Equal(t, int64(42), resp.Data.UserId)
+	}
+}
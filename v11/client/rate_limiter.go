@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// rateLimiterPruneThreshold 桶数量超过该值时清理已回满的按群、按用户令牌桶.
+const rateLimiterPruneThreshold = 1024
+
+// RateLimit 令牌桶参数，Rate 为每秒补充的令牌数，Burst 为桶容量（允许的突发调用数）
+// Rate 小于等于 0 表示不限制，Burst 小于 1 时按 1 处理.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.Rate > 0
+}
+
+// RateLimiter 客户端侧的令牌桶限速器，可按全局、动作、group_id 与 user_id 分别限速
+// group_id 与 user_id 从请求结构体的 GetGroupId、GetUserId 方法读取，例如 SendGroupMsgRequest.GroupId.
+// 一次调用需要同时获得所有匹配的令牌桶的令牌，令牌不足时阻塞直到允许调用或 ctx 取消.
+// 同一个 RateLimiter 可以在多个客户端之间共享，此时各客户端共用相同的令牌桶.
+type RateLimiter struct {
+	options rateLimiterOptions
+
+	mu      sync.Mutex
+	buckets map[rateLimitKey]*tokenBucket
+}
+
+type rateLimiterOptions struct {
+	global  RateLimit
+	actions map[string]RateLimit
+	group   targetRateLimit
+	user    targetRateLimit
+}
+
+// targetRateLimit 按调用目标（群或用户）分别计数的限速配置，actions 为空时对所有携带该目标的动作生效.
+type targetRateLimit struct {
+	limit   RateLimit
+	actions []string
+}
+
+func (t targetRateLimit) applies(action string, id int64) bool {
+	return t.limit.enabled() && id != 0 && (len(t.actions) == 0 || slices.Contains(t.actions, action))
+}
+
+type rateLimitScope int
+
+const (
+	rateLimitScopeGlobal rateLimitScope = iota
+	rateLimitScopeAction
+	rateLimitScopeGroup
+	rateLimitScopeUser
+)
+
+type rateLimitKey struct {
+	scope  rateLimitScope
+	action string
+	id     int64
+}
+
+// RateLimiterOption 用于配置 RateLimiter 的选项函数类型.
+type RateLimiterOption func(*rateLimiterOptions)
+
+// WithGlobalRateLimit 设置所有动作共用的限速.
+func WithGlobalRateLimit(limit RateLimit) RateLimiterOption {
+	return func(o *rateLimiterOptions) { o.global = limit }
+}
+
+// WithActionRateLimit 设置指定动作的限速，例如 send_group_msg.
+func WithActionRateLimit(action string, limit RateLimit) RateLimiterOption {
+	return func(o *rateLimiterOptions) {
+		if o.actions == nil {
+			o.actions = make(map[string]RateLimit)
+		}
+
+		o.actions[action] = limit
+	}
+}
+
+// WithGroupRateLimit 设置每个群的限速，每个 group_id 使用独立的令牌桶
+// 指定 actions 时仅对这些动作生效，否则对所有请求中携带 group_id 的动作生效.
+func WithGroupRateLimit(limit RateLimit, actions ...string) RateLimiterOption {
+	return func(o *rateLimiterOptions) { o.group = targetRateLimit{limit: limit, actions: actions} }
+}
+
+// WithUserRateLimit 设置每个用户的限速，每个 user_id 使用独立的令牌桶
+// 指定 actions 时仅对这些动作生效，否则对所有请求中携带 user_id 的动作生效.
+func WithUserRateLimit(limit RateLimit, actions ...string) RateLimiterOption {
+	return func(o *rateLimiterOptions) { o.user = targetRateLimit{limit: limit, actions: actions} }
+}
+
+// NewRateLimiter 创建限速器，未配置任何限速时不会阻塞调用.
+func NewRateLimiter(opts ...RateLimiterOption) *RateLimiter {
+	var options rateLimiterOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &RateLimiter{
+		options: options,
+		buckets: make(map[rateLimitKey]*tokenBucket),
+	}
+}
+
+// Wait 等待 action 的调用许可，req 为动作的请求结构体，ctx 取消时返回错误并归还已预留的令牌
+// action 的 _async、_rate_limited 后缀在匹配动作限速时忽略，对 nil 的 RateLimiter 调用时直接返回.
+func (l *RateLimiter) Wait(ctx context.Context, action string, req any) error {
+	if l == nil {
+		return nil
+	}
+
+	keys := l.keys(baseAction(action), req)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	wait, buckets := l.reserve(keys, time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel(buckets, time.Now())
+
+		return fmt.Errorf("wait rate limit for %s: %w", action, ctx.Err())
+	}
+}
+
+// keys 返回调用需要获取令牌的所有令牌桶.
+func (l *RateLimiter) keys(action string, req any) []rateLimitKey {
+	var keys []rateLimitKey
+
+	if l.options.global.enabled() {
+		keys = append(keys, rateLimitKey{scope: rateLimitScopeGlobal})
+	}
+
+	if l.options.actions[action].enabled() {
+		keys = append(keys, rateLimitKey{scope: rateLimitScopeAction, action: action})
+	}
+
+	if r, ok := req.(interface{ GetGroupId() int64 }); ok && l.options.group.applies(action, r.GetGroupId()) {
+		keys = append(keys, rateLimitKey{scope: rateLimitScopeGroup, id: r.GetGroupId()})
+	}
+
+	if r, ok := req.(interface{ GetUserId() int64 }); ok && l.options.user.applies(action, r.GetUserId()) {
+		keys = append(keys, rateLimitKey{scope: rateLimitScopeUser, id: r.GetUserId()})
+	}
+
+	return keys
+}
+
+func (l *RateLimiter) limit(key rateLimitKey) RateLimit {
+	switch key.scope {
+	case rateLimitScopeGlobal:
+		return l.options.global
+	case rateLimitScopeAction:
+		return l.options.actions[key.action]
+	case rateLimitScopeGroup:
+		return l.options.group.limit
+	case rateLimitScopeUser:
+		return l.options.user.limit
+	default:
+		return RateLimit{}
+	}
+}
+
+// reserve 从所有令牌桶预留一个令牌，返回需要等待的最长时间.
+func (l *RateLimiter) reserve(keys []rateLimitKey, now time.Time) (time.Duration, []*tokenBucket) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var wait time.Duration
+
+	buckets := make([]*tokenBucket, 0, len(keys))
+
+	for _, key := range keys {
+		bucket, ok := l.buckets[key]
+		if !ok {
+			if len(l.buckets) >= rateLimiterPruneThreshold {
+				l.prune(now)
+			}
+
+			bucket = newTokenBucket(l.limit(key), now)
+			l.buckets[key] = bucket
+		}
+
+		wait = max(wait, bucket.reserve(now))
+		buckets = append(buckets, bucket)
+	}
+
+	return wait, buckets
+}
+
+// cancel 归还预留的令牌.
+func (l *RateLimiter) cancel(buckets []*tokenBucket, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, bucket := range buckets {
+		bucket.refund(now)
+	}
+}
+
+// prune 删除已回满的按群、按用户令牌桶，回满的令牌桶与新建的令牌桶等价.
+func (l *RateLimiter) prune(now time.Time) {
+	for key, bucket := range l.buckets {
+		if key.scope != rateLimitScopeGroup && key.scope != rateLimitScopeUser {
+			continue
+		}
+
+		bucket.advance(now)
+
+		if bucket.full() {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// tokenBucket 令牌桶，tokens 可以为负数，表示已预留但尚未补充的令牌.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	burst := float64(max(limit.Burst, 1))
+
+	return &tokenBucket{rate: limit.Rate, burst: burst, tokens: burst, last: now}
+}
+
+// advance 按经过的时间补充令牌.
+func (b *tokenBucket) advance(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+}
+
+// reserve 预留一个令牌，返回令牌可用前需要等待的时间.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.advance(now)
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) refund(now time.Time) {
+	b.advance(now)
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+func (b *tokenBucket) full() bool {
+	return b.tokens >= b.burst
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_Reserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	bucket := newTokenBucket(RateLimit{Rate: 10, Burst: 2}, now)
+
+	require.Zero(t, bucket.reserve(now))
+	require.Zero(t, bucket.reserve(now))
+	require.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+	require.Equal(t, 200*time.Millisecond, bucket.reserve(now))
+
+	// 归还令牌后等待时间相应缩短
+	bucket.refund(now)
+	require.Equal(t, 200*time.Millisecond, bucket.reserve(now))
+
+	// 经过足够时间后令牌回满，但不超过桶容量
+	later := now.Add(time.Second)
+	require.Zero(t, bucket.reserve(later))
+	require.Zero(t, bucket.reserve(later))
+	require.Positive(t, bucket.reserve(later))
+}
+
+func TestRateLimiter_Keys(t *testing.T) {
+	t.Parallel()
+
+	limit := RateLimit{Rate: 1, Burst: 1}
+	limiter := NewRateLimiter(
+		WithGlobalRateLimit(limit),
+		WithActionRateLimit("send_group_msg", limit),
+		WithGroupRateLimit(limit, "send_group_msg", "send_msg"),
+		WithUserRateLimit(limit),
+	)
+
+	require.Equal(t, []rateLimitKey{
+		{scope: rateLimitScopeGlobal},
+		{scope: rateLimitScopeAction, action: "send_group_msg"},
+		{scope: rateLimitScopeGroup, id: 100},
+	}, limiter.keys("send_group_msg", &entity.SendGroupMsgRequest{GroupId: 100}))
+
+	// 未在 actions 中的动作不按群限速，值为 0 的 user_id 被忽略
+	require.Equal(t, []rateLimitKey{
+		{scope: rateLimitScopeGlobal},
+	}, limiter.keys("get_group_member_info", &entity.GetGroupMemberInfoRequest{GroupId: 100}))
+
+	require.Equal(t, []rateLimitKey{
+		{scope: rateLimitScopeGlobal},
+		{scope: rateLimitScopeUser, id: 200},
+	}, limiter.keys("send_private_msg", &entity.SendPrivateMsgRequest{UserId: 200}))
+
+	require.Empty(t, NewRateLimiter().keys("send_group_msg", &entity.SendGroupMsgRequest{GroupId: 100}))
+}
+
+func TestRateLimiter_PerGroupBuckets(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(WithGroupRateLimit(RateLimit{Rate: 1, Burst: 1}))
+	now := time.Now()
+
+	reserve := func(groupID int64) time.Duration {
+		wait, _ := limiter.reserve(limiter.keys("send_group_msg", &entity.SendGroupMsgRequest{GroupId: groupID}), now)
+
+		return wait
+	}
+
+	require.Zero(t, reserve(1))
+	require.Zero(t, reserve(2))
+	require.Equal(t, time.Second, reserve(1))
+}
+
+func TestRateLimiter_Prune(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(
+		WithGlobalRateLimit(RateLimit{Rate: 1, Burst: 1}),
+		WithUserRateLimit(RateLimit{Rate: 1, Burst: 1}),
+	)
+	now := time.Now()
+
+	for id := range int64(rateLimiterPruneThreshold) {
+		limiter.reserve([]rateLimitKey{{scope: rateLimitScopeUser, id: id + 1}}, now)
+	}
+
+	limiter.reserve([]rateLimitKey{{scope: rateLimitScopeGlobal}}, now)
+	require.Len(t, limiter.buckets, rateLimiterPruneThreshold+1)
+
+	// 回满的按用户令牌桶被清理，全局令牌桶保留
+	limiter.reserve([]rateLimitKey{{scope: rateLimitScopeUser, id: -1}}, now.Add(time.Second))
+	require.Len(t, limiter.buckets, 2)
+	require.Contains(t, limiter.buckets, rateLimitKey{scope: rateLimitScopeGlobal})
+}
+
+func TestRateLimiter_WaitContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(WithActionRateLimit("send_like", RateLimit{Rate: 0.1, Burst: 1}))
+	req := &entity.SendLikeRequest{UserId: 1}
+
+	require.NoError(t, limiter.Wait(context.Background(), "send_like", req))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx, "send_like", req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// 取消的调用归还了令牌，下一次调用只需等待原有的间隔
+	wait, _ := limiter.reserve(limiter.keys("send_like", req), time.Now())
+	require.LessOrEqual(t, wait, 10*time.Second)
+
+	var nilLimiter *RateLimiter
+	require.NoError(t, nilLimiter.Wait(ctx, "send_like", req))
+}
+
+func TestHTTPClient_RateLimiter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(*http.Request) (*http.Response, error) {
+		calls.Add(1)
+
+		return jsonRespOk(`{"status":"ok","retcode":0,"data":{"message_id":1}}`), nil
+	}, WithRateLimiter(NewRateLimiter(WithGroupRateLimit(RateLimit{Rate: 20, Burst: 1}))))
+
+	req := &entity.SendGroupMsgRequest{GroupId: 100}
+	start := time.Now()
+
+	for range 3 {
+		_, err := client.SendGroupMsg(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	require.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestActionClient_RateLimiter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	handler := dispatcher.ActionRequestHandlerFunc(
+		func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			calls.Add(1)
+
+			return &entity.ActionRawResponse{Status: entity.StatusOK, Data: []byte(`{}`)}, nil
+		},
+	)
+	limiter := NewRateLimiter(WithActionRateLimit("send_group_msg", RateLimit{Rate: 0.1, Burst: 1}))
+	client := NewActionClient(handler, WithActionRateLimiter(limiter))
+
+	_, err := client.SendGroupMsg(context.Background(), &entity.SendGroupMsgRequest{GroupId: 1})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// 令牌耗尽时阻塞，ctx 超时后返回且不调用动作
+	_, err = client.SendGroupMsg(ctx, &entity.SendGroupMsgRequest{GroupId: 1})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, int32(1), calls.Load())
+}
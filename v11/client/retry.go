@@ -0,0 +1,102 @@
+package client
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+const (
+	defaultRetryMinBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff = 2 * time.Second
+)
+
+// RetryPolicy HTTPClient 动作调用的重试策略
+// 网络错误以及 Retcodes 中的返回码会触发重试，HTTP 状态码按 entity.RetcodeFromHTTPStatus 换算后匹配.
+// 只读动作（get_*、can_*）默认按策略重试，其余动作需要在单次调用中通过 WithRetry 开启.
+type RetryPolicy struct {
+	// MaxAttempts 最大尝试次数（含首次），小于等于 1 表示不重试
+	MaxAttempts int
+	// MinBackoff 首次重试前的等待时间，之后每次翻倍，默认 100 毫秒
+	MinBackoff time.Duration
+	// MaxBackoff 等待时间上限，默认 2 秒
+	MaxBackoff time.Duration
+	// Jitter 等待时间的随机抖动比例，取值 0~1，例如 0.2 表示在 ±20% 范围内随机
+	Jitter float64
+	// Retcodes 需要重试的返回码，为空时重试 1429（调用频率限制）与 1500~1599（服务端错误）
+	Retcodes []entity.ActionResponseRetcode
+}
+
+// attempts 返回动作的最大尝试次数，有副作用的动作未开启重试时只尝试一次.
+func (p *RetryPolicy) attempts(action string, optIn bool) int {
+	if p.MaxAttempts <= 1 || (!optIn && !isReadOnlyAction(action)) {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// retryable 判断错误是否可以重试.
+func (p *RetryPolicy) retryable(err error) bool {
+	var (
+		actionErr *entity.ActionError
+		statusErr *entity.HTTPStatusError
+	)
+
+	switch {
+	case errors.As(err, &actionErr):
+		return p.retryableRetcode(actionErr.Retcode)
+	case errors.As(err, &statusErr):
+		return p.retryableRetcode(entity.RetcodeFromHTTPStatus(statusErr.StatusCode))
+	default:
+		return errors.Is(err, entity.ErrNetwork)
+	}
+}
+
+func (p *RetryPolicy) retryableRetcode(retcode entity.ActionResponseRetcode) bool {
+	if len(p.Retcodes) > 0 {
+		return slices.Contains(p.Retcodes, retcode)
+	}
+
+	return retcode == entity.RetcodeTooManyRequests ||
+		(retcode >= entity.RetcodeInternalError && retcode < entity.RetcodeInternalError+100)
+}
+
+// backoff 返回第 attempt 次尝试失败后的等待时间.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff := p.MinBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	wait := minBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	wait = min(wait, max(maxBackoff, minBackoff))
+
+	if p.Jitter > 0 {
+		jitter := min(p.Jitter, 1)
+		//nolint:gosec // 退避抖动不需要密码学安全的随机数
+		wait = time.Duration(float64(wait) * (1 + jitter*(2*rand.Float64()-1)))
+	}
+
+	return wait
+}
+
+// isReadOnlyAction 判断动作是否为只读动作，忽略隐藏 API 的 . 与 _ 前缀.
+func isReadOnlyAction(action string) bool {
+	name := strings.TrimLeft(action, "/._")
+
+	return strings.HasPrefix(name, "get_") || strings.HasPrefix(name, "can_")
+}
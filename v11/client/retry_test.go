@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func statusResp(code int) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Status:     http.StatusText(code),
+		Body:       io.NopCloser(strings.NewReader(http.StatusText(code))),
+		Header:     make(http.Header),
+	}
+}
+
+// flakyClient 前 failures 次请求返回 resp，之后返回成功，calls 记录请求次数.
+func flakyClient(
+	t *testing.T, failures int32, resp func() (*http.Response, error), opts ...Option,
+) (*HTTPClient, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	client := newTestClient(t, func(*http.Request) (*http.Response, error) {
+		if calls.Add(1) <= failures {
+			return resp()
+		}
+
+		return jsonRespOk(`{"status":"ok","retcode":0,"data":{}}`), nil
+	}, opts...)
+
+	return client, &calls
+}
+
+func TestHTTPClient_Retry_ReadOnlyAction(t *testing.T) {
+	t.Parallel()
+
+	client, calls := flakyClient(t, 2, func() (*http.Response, error) {
+		return statusResp(http.StatusServiceUnavailable), nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+
+	_, err := client.do(context.Background(), "get_status", http.MethodPost, struct{}{})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestHTTPClient_Retry_GivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	client, calls := flakyClient(t, 5, func() (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+
+	_, err := client.do(context.Background(), "get_status", http.MethodPost, struct{}{})
+	require.ErrorIs(t, err, entity.ErrNetwork)
+	require.Equal(t, int32(3), calls.Load())
+}
+
+func TestHTTPClient_Retry_SideEffectActionRequiresOptIn(t *testing.T) {
+	t.Parallel()
+
+	policy := WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond})
+	rateLimited := func() (*http.Response, error) {
+		return jsonRespOk(`{"status":"failed","retcode":1429,"data":null}`), nil
+	}
+
+	client, calls := flakyClient(t, 1, rateLimited, policy)
+	_, err := client.SendPrivateMsg(context.Background(), &entity.SendPrivateMsgRequest{UserId: 1})
+	require.ErrorIs(t, err, entity.ErrRateLimited)
+	require.Equal(t, int32(1), calls.Load())
+
+	client, calls = flakyClient(t, 1, rateLimited, policy)
+	_, err = client.SendPrivateMsg(context.Background(), &entity.SendPrivateMsgRequest{UserId: 1}, WithRetry())
+	require.NoError(t, err)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestHTTPClient_Retry_NonRetryableError(t *testing.T) {
+	t.Parallel()
+
+	client, calls := flakyClient(t, 1, func() (*http.Response, error) {
+		return statusResp(http.StatusBadRequest), nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}))
+
+	_, err := client.do(context.Background(), "get_status", http.MethodPost, struct{}{})
+	require.ErrorIs(t, err, entity.ErrBadRequest)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestHTTPClient_Retry_ContextCanceledDuringBackoff(t *testing.T) {
+	t.Parallel()
+
+	client, calls := flakyClient(t, 5, func() (*http.Response, error) {
+		return statusResp(http.StatusBadGateway), nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.do(ctx, "get_status", http.MethodPost, struct{}{})
+	require.ErrorIs(t, err, entity.ErrHTTPStatus)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryPolicy_Retcodes(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{Retcodes: []entity.ActionResponseRetcode{100}}
+	require.True(t, policy.retryable(&entity.ActionError{Retcode: 100}))
+	require.False(t, policy.retryable(&entity.ActionError{Retcode: entity.RetcodeTooManyRequests}))
+
+	var defaults RetryPolicy
+	require.True(t, defaults.retryable(&entity.HTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+	require.True(t, defaults.retryable(&entity.ActionError{Retcode: entity.RetcodeInternalError}))
+	require.False(t, defaults.retryable(&entity.ActionError{Retcode: 100}))
+}
+
+func TestRetryPolicy_Attempts(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3}
+	require.Equal(t, 3, policy.attempts("get_msg", false))
+	require.Equal(t, 3, policy.attempts("/can_send_image", false))
+	require.Equal(t, 3, policy.attempts("_get_model_show", false))
+	require.Equal(t, 1, policy.attempts("send_msg", false))
+	require.Equal(t, 1, policy.attempts("set_group_ban", false))
+	require.Equal(t, 3, policy.attempts("set_group_ban", true))
+
+	var none RetryPolicy
+	require.Equal(t, 1, none.attempts("get_msg", true))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MinBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	require.Equal(t, 10*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 20*time.Millisecond, policy.backoff(2))
+	require.Equal(t, 40*time.Millisecond, policy.backoff(3))
+	require.Equal(t, 50*time.Millisecond, policy.backoff(4))
+
+	policy.Jitter = 0.5
+	for attempt := range 5 {
+		wait := policy.backoff(attempt + 1)
+		require.GreaterOrEqual(t, wait, 5*time.Millisecond)
+		require.LessOrEqual(t, wait, 75*time.Millisecond)
+	}
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -15,29 +17,43 @@ import (
 	"github.com/q1bksuu/onebot-go-sdk/v11/server"
 )
 
+const defaultWSEventQueueSize = 128
+
 // WSClientConfig WebSocket 客户端配置.
+// URL、APIURL、EventURL 对应 go-cqhttp 的 ws_reverse_url、ws_reverse_api_url、ws_reverse_event_url，
+// 三者相互独立，分别以 Universal、API、Event 角色建立连接.
 type WSClientConfig struct {
-	URL               string
-	ReconnectInterval time.Duration // 断线重连间隔
-	SelfID            int64         // 机器人 QQ 号（用于 X-Self-ID 请求头）
-	AccessToken       string        // 可选鉴权令牌
-	ReadTimeout       time.Duration // 读取超时（可选），默认 0
-	WriteTimeout      time.Duration // 写入超时（可选），默认 0
+	URL               string           // Universal 连接地址
+	APIURL            string           // API 连接地址（可选），只接受动作请求
+	EventURL          string           // Event 连接地址（可选），只推送事件
+	Targets           []WSClientTarget // 额外的连接目标（可选），每个地址独立连接与重连
+	ReconnectInterval time.Duration    // 断线重连间隔
+	SelfID            int64            // 机器人 QQ 号（用于 X-Self-ID 请求头）
+	AccessToken       string           // 可选鉴权令牌
+	ReadTimeout       time.Duration    // 读取超时（可选），默认 0
+	WriteTimeout      time.Duration    // 写入超时（可选），默认 0
+	EventQueueSize    int              // 断线期间每个事件连接缓存的事件数，默认 128，负数表示不缓存
+}
+
+// WSClientTarget 反向 WebSocket 连接目标，字段含义与 WSClientConfig 中的同名字段相同.
+type WSClientTarget struct {
+	URL      string // Universal 连接地址
+	APIURL   string // API 连接地址
+	EventURL string // Event 连接地址
 }
 
 // WSClientOption 用于配置 WebSocketClient 的选项函数类型.
 type WSClientOption func(*WebSocketClient)
 
-// WebSocketClient 实现 OneBot 反向 WebSocket 传输层.
+// WebSocketClient 实现 OneBot 反向 WebSocket 传输层
+// 每个连接地址独立连接与重连，Universal / API 连接处理动作请求，Universal / Event 连接推送事件.
 type WebSocketClient struct {
 	cfg           WSClientConfig
 	actionHandler dispatcher.ActionRequestHandler
-
-	// 连接管理
-	mu   sync.Mutex
-	conn *websocket.Conn
+	endpoints     []*wsClientEndpoint
 
 	// 控制
+	mu     sync.Mutex
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 }
@@ -49,13 +65,34 @@ func WithWSConfig(cfg WSClientConfig) WSClientOption {
 	}
 }
 
-// WithWSURL 设置 WebSocket 连接地址.
+// WithWSURL 设置 Universal 连接地址.
 func WithWSURL(url string) WSClientOption {
 	return func(c *WebSocketClient) {
 		c.cfg.URL = url
 	}
 }
 
+// WithWSAPIURL 设置 API 连接地址.
+func WithWSAPIURL(url string) WSClientOption {
+	return func(c *WebSocketClient) {
+		c.cfg.APIURL = url
+	}
+}
+
+// WithWSEventURL 设置 Event 连接地址.
+func WithWSEventURL(url string) WSClientOption {
+	return func(c *WebSocketClient) {
+		c.cfg.EventURL = url
+	}
+}
+
+// WithWSTargets 追加连接目标.
+func WithWSTargets(targets ...WSClientTarget) WSClientOption {
+	return func(c *WebSocketClient) {
+		c.cfg.Targets = append(c.cfg.Targets, targets...)
+	}
+}
+
 // WithWSReconnectInterval 设置断线重连间隔.
 func WithWSReconnectInterval(interval time.Duration) WSClientOption {
 	return func(c *WebSocketClient) {
@@ -91,6 +128,13 @@ func WithWSWriteTimeout(timeout time.Duration) WSClientOption {
 	}
 }
 
+// WithWSEventQueueSize 设置断线期间每个事件连接缓存的事件数，负数表示不缓存.
+func WithWSEventQueueSize(size int) WSClientOption {
+	return func(c *WebSocketClient) {
+		c.cfg.EventQueueSize = size
+	}
+}
+
 // WithWSActionHandler 设置动作请求处理器.
 func WithWSActionHandler(handler dispatcher.ActionRequestHandler) WSClientOption {
 	return func(c *WebSocketClient) {
@@ -100,11 +144,9 @@ func WithWSActionHandler(handler dispatcher.ActionRequestHandler) WSClientOption
 
 // NewWebSocketClient 创建反向 WebSocket 客户端，配置由 opts 提供.
 func NewWebSocketClient(opts ...WSClientOption) *WebSocketClient {
-	_, cancel := context.WithCancel(context.Background())
-
 	client := &WebSocketClient{
 		cfg:    WSClientConfig{},
-		cancel: cancel,
+		cancel: func() {},
 	}
 
 	// 应用选项
@@ -112,28 +154,31 @@ func NewWebSocketClient(opts ...WSClientOption) *WebSocketClient {
 		opt(client)
 	}
 
+	client.endpoints = client.buildEndpoints()
+
 	return client
 }
 
-// Start 启动客户端，建立连接并开始处理消息.
+// Start 启动客户端，建立所有连接并开始处理消息，直到 ctx 取消或调用 Shutdown.
 func (c *WebSocketClient) Start(ctx context.Context) error {
-	mergedCtx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	go func() {
-		<-mergedCtx.Done()
-		c.cancel()
-	}()
-
-	if c.cfg.URL == "" {
+	if len(c.endpoints) == 0 {
 		return server.ErrUniversalClientURLEmpty
 	}
 
-	c.wg.Add(1)
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c.mu.Lock()
+	c.cancel = cancel
+	c.mu.Unlock()
+
+	for _, ep := range c.endpoints {
+		c.wg.Add(1)
 
-	go c.run(mergedCtx, c.cfg.URL)
+		go c.run(runCtx, ep)
+	}
 
-	<-mergedCtx.Done()
+	<-runCtx.Done()
 	c.wg.Wait()
 
 	return nil
@@ -141,14 +186,16 @@ func (c *WebSocketClient) Start(ctx context.Context) error {
 
 // Shutdown 优雅关闭所有连接.
 func (c *WebSocketClient) Shutdown(ctx context.Context) error {
-	c.cancel()
-
 	c.mu.Lock()
-	conn := c.conn
+	cancel := c.cancel
 	c.mu.Unlock()
 
-	if conn != nil {
-		_ = conn.Close()
+	cancel()
+
+	for _, ep := range c.endpoints {
+		if conn := ep.current(); conn != nil {
+			_ = conn.Close()
+		}
 	}
 
 	done := make(chan struct{})
@@ -166,27 +213,28 @@ func (c *WebSocketClient) Shutdown(ctx context.Context) error {
 	}
 }
 
-// BroadcastEvent 推送事件到 Event 或 Universal 连接.
+// BroadcastEvent 推送事件到所有 Event 与 Universal 连接
+// 连接断开时事件进入该连接的缓冲区，重连后按顺序补发；缓冲区已满时丢弃事件并返回 server.ErrEventQueueFull.
 func (c *WebSocketClient) BroadcastEvent(event entity.Event) error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	var conns []*websocket.Conn
-
-	if len(conns) == 0 {
-		return nil
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encode event: %w", err)
 	}
 
-	var lastErr error
+	var errs []error
 
-	for _, conn := range conns {
-		err := conn.WriteJSON(event)
+	for _, ep := range c.endpoints {
+		if ep.role == server.ReverseWSRoleAPI {
+			continue
+		}
+
+		err := ep.push(data, c.eventQueueSize(), c.cfg.WriteTimeout)
 		if err != nil {
-			lastErr = err
+			errs = append(errs, err)
 		}
 	}
 
-	return lastErr
+	return errors.Join(errs...)
 }
 
 func (c *WebSocketClient) buildHeaders(clientRole string) http.Header {
@@ -201,89 +249,86 @@ func (c *WebSocketClient) buildHeaders(clientRole string) http.Header {
 	return headers
 }
 
-// dialWithReconnect 建立连接，支持断线重连.
-func (c *WebSocketClient) dialWithReconnect(
-	ctx context.Context, url string, headers http.Header,
-) (*websocket.Conn, error) {
-	dialer := websocket.Dialer{
-		HandshakeTimeout: 10 * time.Second,
-	}
+// buildEndpoints 根据配置生成连接端点，顶层地址在前，Targets 按顺序在后.
+func (c *WebSocketClient) buildEndpoints() []*wsClientEndpoint {
+	targets := append([]WSClientTarget{{
+		URL:      c.cfg.URL,
+		APIURL:   c.cfg.APIURL,
+		EventURL: c.cfg.EventURL,
+	}}, c.cfg.Targets...)
 
 	reconnectInterval := c.cfg.ReconnectInterval
 	if reconnectInterval == 0 {
-		reconnectInterval = 3 * time.Second
+		reconnectInterval = defaultWSReconnectInterval
 	}
 
-	for {
-		if ctx.Err() != nil {
-			return nil, fmt.Errorf("dial context canceled: %w", ctx.Err())
-		}
+	var endpoints []*wsClientEndpoint
 
-		conn, resp, err := dialer.Dial(url, headers)
-		if err == nil {
-			_ = resp.Body.Close()
+	for _, target := range targets {
+		for _, ep := range []*wsClientEndpoint{
+			{url: target.URL, role: server.ReverseWSRoleUniversal},
+			{url: target.APIURL, role: server.ReverseWSRoleAPI},
+			{url: target.EventURL, role: server.ReverseWSRoleEvent},
+		} {
+			if ep.url == "" {
+				continue
+			}
 
-			return conn, nil
+			ep.dialer = wsDialer{
+				url:              ep.url,
+				header:           c.buildHeaders(string(ep.role)),
+				handshakeTimeout: defaultWSHandshakeTimeout,
+				minBackoff:       reconnectInterval,
+				maxBackoff:       reconnectInterval,
+			}
+			endpoints = append(endpoints, ep)
 		}
+	}
 
-		// 等待重连间隔
-		select {
-		case <-ctx.Done():
-			return nil, fmt.Errorf("reconnect context canceled: %w", ctx.Err())
-		case <-time.After(reconnectInterval):
-		}
+	return endpoints
+}
+
+func (c *WebSocketClient) eventQueueSize() int {
+	if c.cfg.EventQueueSize == 0 {
+		return defaultWSEventQueueSize
 	}
+
+	return max(c.cfg.EventQueueSize, 0)
 }
 
-// run 运行客户端.
-func (c *WebSocketClient) run(ctx context.Context, url string) {
+// run 维持单个端点的连接，断开后重连.
+func (c *WebSocketClient) run(ctx context.Context, ep *wsClientEndpoint) {
 	defer c.wg.Done()
 
 	for ctx.Err() == nil {
-		headers := c.buildHeaders("Universal")
-
-		conn, err := c.dialWithReconnect(ctx, url, headers)
+		conn, err := ep.dialer.dial(ctx)
 		if err != nil {
 			return
 		}
 
-		c.mu.Lock()
-		c.conn = conn
-		c.mu.Unlock()
-
-		apiCtx, apiCancel := context.WithCancel(ctx)
-		apiDone := make(chan struct{})
-
-		go func() {
-			defer close(apiDone)
-
-			c.serveActionConn(apiCtx, conn)
-		}()
-
-		select {
-		case <-ctx.Done():
-			apiCancel()
-			<-apiDone
-
-			c.clearConn(conn)
-			_ = conn.Close()
-
-			return
-		case <-apiDone:
-			apiCancel()
+		// 补发断线期间缓存的事件，失败时保留未发送的事件并重连
+		err = ep.attach(conn, c.cfg.WriteTimeout)
+		if err == nil {
+			c.serveConn(ctx, ep, conn)
+			ep.clearConn(conn)
 		}
 
-		c.clearConn(conn)
 		_ = conn.Close()
 	}
 }
 
-func (c *WebSocketClient) serveActionConn(ctx context.Context, conn *websocket.Conn) {
-	if c.actionHandler == nil {
-		<-ctx.Done()
+// serveConn 读取连接上的消息直到连接断开或 ctx 取消.
+func (c *WebSocketClient) serveConn(ctx context.Context, ep *wsClientEndpoint, conn *websocket.Conn) {
+	stop := make(chan struct{})
+	defer close(stop)
 
-		return
-	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-stop:
+		}
+	}()
 
 	for ctx.Err() == nil {
 		if c.cfg.ReadTimeout > 0 {
@@ -298,16 +343,14 @@ func (c *WebSocketClient) serveActionConn(ctx context.Context, conn *websocket.C
 			return
 		}
 
-		resp := c.handleActionMessage(ctx, data)
-
-		if c.cfg.WriteTimeout > 0 {
-			err := conn.SetWriteDeadline(time.Now().Add(c.cfg.WriteTimeout))
-			if err != nil {
-				return
-			}
+		// Event 连接只推送事件，忽略收到的消息
+		if ep.role == server.ReverseWSRoleEvent || c.actionHandler == nil {
+			continue
 		}
 
-		err = conn.WriteJSON(resp)
+		resp := c.handleActionMessage(ctx, data)
+
+		err = ep.writeJSON(conn, resp, c.cfg.WriteTimeout)
 		if err != nil {
 			return
 		}
@@ -318,12 +361,121 @@ func (c *WebSocketClient) handleActionMessage(ctx context.Context, data []byte)
 	return wsinternal.HandleActionMessage(ctx, data, c.actionHandler, server.ErrBadRequest)
 }
 
-func (c *WebSocketClient) clearConn(conn *websocket.Conn) {
-	c.mu.Lock()
+// wsClientEndpoint 单个反向 WebSocket 连接地址，持有当前连接与断线期间的事件缓冲区.
+type wsClientEndpoint struct {
+	url    string
+	role   server.ReverseWSClientRole
+	dialer wsDialer
+
+	// mu 保护 conn 与 queue；writeMu 串行化当前连接上的写入，写入时不持有 mu
+	mu      sync.Mutex
+	writeMu sync.Mutex
+	conn    *websocket.Conn
+	queue   [][]byte
+}
 
-	if c.conn == conn {
-		c.conn = nil
+func (e *wsClientEndpoint) current() *websocket.Conn {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.conn
+}
+
+// attach 补发缓冲区中的事件后将 conn 设为当前连接
+// 补发期间持有 mu，新事件进入缓冲区排在补发的事件之后；conn 尚未成为当前连接，没有其他写入方.
+func (e *wsClientEndpoint) attach(conn *websocket.Conn, writeTimeout time.Duration) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for len(e.queue) > 0 {
+		err := writeMessage(conn, e.queue[0], writeTimeout)
+		if err != nil {
+			return err
+		}
+
+		e.queue = e.queue[1:]
 	}
 
-	c.mu.Unlock()
+	e.queue = nil
+	e.conn = conn
+
+	return nil
+}
+
+func (e *wsClientEndpoint) clearConn(conn *websocket.Conn) {
+	e.mu.Lock()
+
+	if e.conn == conn {
+		e.conn = nil
+	}
+
+	e.mu.Unlock()
+}
+
+// push 通过当前连接发送事件，未连接或发送失败时放入缓冲区.
+func (e *wsClientEndpoint) push(data []byte, queueSize int, writeTimeout time.Duration) error {
+	conn := e.current()
+	if conn != nil {
+		err := e.write(conn, data, writeTimeout)
+		if err == nil {
+			return nil
+		}
+	}
+
+	e.mu.Lock()
+
+	if conn != nil && e.conn == conn {
+		// 发送失败的连接不再可用，关闭后由读取循环重连
+		_ = conn.Close()
+		e.conn = nil
+	}
+
+	if e.conn != nil {
+		// 写入期间已建立新连接，缓冲区已经补发，通过新连接发送
+		e.mu.Unlock()
+
+		return e.push(data, queueSize, writeTimeout)
+	}
+
+	defer e.mu.Unlock()
+
+	if len(e.queue) >= queueSize {
+		return fmt.Errorf("%w: %s", server.ErrEventQueueFull, e.url)
+	}
+
+	e.queue = append(e.queue, data)
+
+	return nil
+}
+
+func (e *wsClientEndpoint) writeJSON(conn *websocket.Conn, v any, writeTimeout time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode message: %w", err)
+	}
+
+	return e.write(conn, data, writeTimeout)
+}
+
+func (e *wsClientEndpoint) write(conn *websocket.Conn, data []byte, writeTimeout time.Duration) error {
+	e.writeMu.Lock()
+	defer e.writeMu.Unlock()
+
+	return writeMessage(conn, data, writeTimeout)
+}
+
+func writeMessage(conn *websocket.Conn, data []byte, writeTimeout time.Duration) error {
+	if writeTimeout > 0 {
+		err := conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if err != nil {
+			return fmt.Errorf("set write deadline: %w", err)
+		}
+	}
+
+	err := conn.WriteMessage(websocket.TextMessage, data)
+	if err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	return nil
 }
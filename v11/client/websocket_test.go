@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -268,9 +269,10 @@ func TestWebSocketClient_DialWithReconnect_ContextCanceled(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	client := NewWebSocketClient(WithWSReconnectInterval(10 * time.Millisecond))
+	client := NewWebSocketClient(WithWSURL("ws://example.com/ws"), WithWSReconnectInterval(10*time.Millisecond))
+	require.Len(t, client.endpoints, 1)
 
-	_, err := client.dialWithReconnect(ctx, "ws://example.com/ws", http.Header{})
+	_, err := client.endpoints[0].dialer.dial(ctx)
 	require.Error(t, err)
 	require.ErrorIs(t, err, context.Canceled)
 	require.ErrorContains(t, err, "dial context canceled")
@@ -300,12 +302,53 @@ func TestWebSocketClient_BroadcastEvent_NoConn(t *testing.T) {
 func TestWebSocketClient_ClearConn(t *testing.T) {
 	t.Parallel()
 
-	client := NewWebSocketClient()
+	ep := &wsClientEndpoint{}
 	conn := &websocket.Conn{}
-	client.conn = conn
+	ep.conn = conn
+
+	ep.clearConn(&websocket.Conn{})
+	require.Same(t, conn, ep.current())
 
-	client.clearConn(conn)
-	require.Nil(t, client.conn)
+	ep.clearConn(conn)
+	require.Nil(t, ep.current())
+}
+
+func TestWebSocketClient_PushWritesOutsideLock(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan string, 1)
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		_, data, err := conn.ReadMessage()
+		if err == nil {
+			received <- string(data)
+		}
+	})
+
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ep := &wsClientEndpoint{url: url}
+	require.NoError(t, ep.attach(conn, 0))
+
+	// 模拟阻塞的写入：写入等待期间仍可读取当前连接与缓冲区
+	ep.writeMu.Lock()
+
+	pushed := make(chan error, 1)
+
+	go func() {
+		pushed <- ep.push([]byte(`{"post_type":"meta_event"}`), 1, 0)
+	}()
+
+	require.Same(t, conn, ep.current())
+	ep.writeMu.Unlock()
+
+	require.NoError(t, <-pushed)
+	require.JSONEq(t, `{"post_type":"meta_event"}`, <-received)
 }
 
 func TestWebSocketClient_Start_RunServeActionConn(t *testing.T) {
@@ -362,3 +405,173 @@ func TestWebSocketClient_Start_RunServeActionConn(t *testing.T) {
 	default:
 	}
 }
+
+// startReverseWSServer 启动接收反向 WebSocket 连接的服务端，收到的事件写入通道.
+func startReverseWSServer(
+	t *testing.T, events chan<- entity.Event, sessions *server.SessionRegistry,
+) (*server.ReverseWebSocketServer, string) {
+	t.Helper()
+
+	srv := server.NewReverseWebSocketServer(
+		server.WithReverseWSSessionRegistry(sessions),
+		server.WithReverseWSEventHandler(server.EventRequestHandlerFunc(
+			func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+				events <- event
+
+				return nil, nil
+			},
+		)),
+	)
+	ts := httptest.NewServer(srv.Handler())
+	t.Cleanup(ts.Close)
+
+	return srv, "ws" + strings.TrimPrefix(ts.URL, "http")
+}
+
+func mustParseEvent(t *testing.T, data string) entity.Event {
+	t.Helper()
+
+	event, err := entity.ParseEvent([]byte(data))
+	require.NoError(t, err)
+
+	return event
+}
+
+func heartbeatEvent(t *testing.T, time int64) entity.Event {
+	t.Helper()
+
+	return mustParseEvent(t, fmt.Sprintf(
+		`{"time":%d,"self_id":10000,"post_type":"meta_event","meta_event_type":"heartbeat","interval":5000}`, time))
+}
+
+func startWebSocketClient(t *testing.T, opts ...WSClientOption) *WebSocketClient {
+	t.Helper()
+
+	opts = append([]WSClientOption{
+		WithWSSelfID(10000),
+		WithWSReconnectInterval(10 * time.Millisecond),
+	}, opts...)
+	client := NewWebSocketClient(opts...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = client.Start(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return client
+}
+
+func TestWebSocketClient_SeparateAPIAndEvent(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan entity.Event, 1)
+	sessions := server.NewSessionRegistry()
+	srv, url := startReverseWSServer(t, events, sessions)
+
+	client := startWebSocketClient(t,
+		WithWSAPIURL(url+"/api"),
+		WithWSEventURL(url+"/event"),
+		WithWSActionHandler(newPingActionHandler()),
+	)
+
+	require.Eventually(t, func() bool {
+		session, ok := sessions.Get(10000)
+
+		return ok && len(session.Transports) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, client.BroadcastEvent(mustParseEvent(t, testPrivateMessageEvent)))
+
+	event, ok := (<-events).(*entity.PrivateMessageEvent)
+	require.True(t, ok)
+	require.Equal(t, int64(20000), event.UserId)
+
+	bot, ok := srv.Bot(10000)
+	require.True(t, ok)
+
+	resp, err := bot.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "ping"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"result":"ok"}`, string(resp.Data))
+}
+
+func TestWebSocketClient_EventQueueWhileDisconnected(t *testing.T) {
+	t.Parallel()
+
+	received := make(chan string, 2)
+	url := newWSActionServer(t, func(conn *websocket.Conn, r *http.Request) {
+		received <- r.Header.Get("X-Client-Role")
+
+		for range 2 {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+
+			received <- string(data)
+		}
+
+		_, _, _ = conn.ReadMessage()
+	})
+
+	client := NewWebSocketClient(
+		WithWSSelfID(10000),
+		WithWSEventURL(url+"/event"),
+		WithWSEventQueueSize(2),
+		WithWSReconnectInterval(10*time.Millisecond),
+	)
+
+	for i := range int64(3) {
+		err := client.BroadcastEvent(heartbeatEvent(t, i))
+		if i < 2 {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, server.ErrEventQueueFull)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() {
+		_ = client.Start(ctx)
+	}()
+
+	// 连接建立后按顺序补发缓存的事件
+	require.Equal(t, "Event", <-received)
+	require.Equal(t, int64(0), mustParseEvent(t, <-received).GetTime())
+	require.Equal(t, int64(1), mustParseEvent(t, <-received).GetTime())
+}
+
+func TestWebSocketClient_MultipleTargets(t *testing.T) {
+	t.Parallel()
+
+	first := make(chan entity.Event, 1)
+	second := make(chan entity.Event, 1)
+	firstSessions := server.NewSessionRegistry()
+	secondSessions := server.NewSessionRegistry()
+	_, firstURL := startReverseWSServer(t, first, firstSessions)
+	_, secondURL := startReverseWSServer(t, second, secondSessions)
+
+	client := startWebSocketClient(t,
+		WithWSURL(firstURL),
+		WithWSTargets(WSClientTarget{EventURL: secondURL + "/event"}),
+	)
+
+	require.Eventually(t, func() bool {
+		return firstSessions.Len() == 1 && secondSessions.Len() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, client.BroadcastEvent(heartbeatEvent(t, 1)))
+
+	require.IsType(t, &entity.HeartbeatEvent{}, <-first)
+	require.IsType(t, &entity.HeartbeatEvent{}, <-second)
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	wsinternal "github.com/q1bksuu/onebot-go-sdk/v11/internal/ws"
+)
+
+var (
+	errWSURLEmpty      = errors.New("websocket url is empty")
+	errWSClientClosed  = errors.New("websocket action client closed")
+	errWSClientRunning = errors.New("websocket action client already started")
+)
+
+const (
+	defaultWSReconnectInterval = 3 * time.Second
+	defaultWSHandshakeTimeout  = 10 * time.Second
+	defaultWSActionTimeout     = 30 * time.Second
+)
+
+// WSActionClient 通过正向 WebSocket（/api 或 /）调用 OneBot 动作
+// 每个请求携带唯一的 echo，响应按 echo 关联，支持多个调用并发进行.
+// 连接断开后自动重连，断开时等待中的调用以 entity.ErrNetwork 失败.
+// 动作方法由内嵌的 ActionClient 提供，底层 handler 为客户端自身.
+type WSActionClient struct {
+	*ActionClient
+
+	dialer  wsDialer
+	options wsActionClientOptions
+	holder  *wsinternal.ConnHolder
+
+	// 控制
+	mu        sync.Mutex
+	running   bool
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+type wsActionClientOptions struct {
+	accessToken       string
+	reconnectInterval time.Duration
+	handshakeTimeout  time.Duration
+	writeTimeout      time.Duration
+	timeout           time.Duration
+	limiter           *RateLimiter
+	interceptors      []Interceptor
+}
+
+// WSActionClientOption 用于配置 WSActionClient 的选项函数类型.
+type WSActionClientOption func(*wsActionClientOptions)
+
+// WithWSActionAccessToken 设置访问令牌，连接时附加 Authorization Bearer 头.
+func WithWSActionAccessToken(token string) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.accessToken = token }
+}
+
+// WithWSActionReconnectInterval 设置断线重连间隔，默认 3 秒.
+func WithWSActionReconnectInterval(interval time.Duration) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.reconnectInterval = interval }
+}
+
+// WithWSActionHandshakeTimeout 设置握手超时，默认 10 秒.
+func WithWSActionHandshakeTimeout(timeout time.Duration) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.handshakeTimeout = timeout }
+}
+
+// WithWSActionWriteTimeout 设置写入超时（可选），默认 0.
+func WithWSActionWriteTimeout(timeout time.Duration) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.writeTimeout = timeout }
+}
+
+// WithWSActionTimeout 设置单次调用的默认超时，包含等待连接与等待响应的时间，默认 30 秒，0 表示不限制.
+func WithWSActionTimeout(timeout time.Duration) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.timeout = timeout }
+}
+
+// WithWSActionRateLimiter 设置客户端侧限速器，调用动作前等待许可.
+func WithWSActionRateLimiter(limiter *RateLimiter) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.limiter = limiter }
+}
+
+// WithWSActionInterceptors 追加动作调用拦截器，先添加的拦截器位于外层.
+func WithWSActionInterceptors(interceptors ...Interceptor) WSActionClientOption {
+	return func(o *wsActionClientOptions) { o.interceptors = append(o.interceptors, interceptors...) }
+}
+
+// NewWSActionClient 创建正向 WebSocket 动作客户端，调用 Start 后开始连接.
+func NewWSActionClient(url string, opts ...WSActionClientOption) (*WSActionClient, error) {
+	if strings.TrimSpace(url) == "" {
+		return nil, fmt.Errorf("%w", errWSURLEmpty)
+	}
+
+	options := wsActionClientOptions{
+		reconnectInterval: defaultWSReconnectInterval,
+		handshakeTimeout:  defaultWSHandshakeTimeout,
+		timeout:           defaultWSActionTimeout,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	client := &WSActionClient{
+		dialer: wsDialer{
+			url:              url,
+			accessToken:      options.accessToken,
+			handshakeTimeout: options.handshakeTimeout,
+			minBackoff:       options.reconnectInterval,
+			maxBackoff:       options.reconnectInterval,
+		},
+		options: options,
+		holder:  wsinternal.NewConnHolder(),
+		done:    make(chan struct{}),
+	}
+	client.ActionClient = NewActionClient(client,
+		WithActionRateLimiter(options.limiter),
+		WithActionInterceptors(options.interceptors...),
+	)
+
+	return client, nil
+}
+
+// Start 建立连接并处理响应，断线后自动重连，直到 ctx 取消或调用 Shutdown
+// 同一时间只能有一个 Start 在运行，Start 返回后可以再次启动；已调用 Shutdown 的客户端不能再次启动.
+func (c *WSActionClient) Start(ctx context.Context) error {
+	c.mu.Lock()
+	select {
+	case <-c.done:
+		c.mu.Unlock()
+
+		return fmt.Errorf("%w", errWSClientClosed)
+	default:
+	}
+
+	if c.running {
+		c.mu.Unlock()
+
+		return fmt.Errorf("%w", errWSClientRunning)
+	}
+
+	c.running = true
+	c.wg.Add(1)
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.running = false
+		c.mu.Unlock()
+		c.wg.Done()
+	}()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-c.done:
+			cancel()
+		case <-runCtx.Done():
+		}
+	}()
+
+	for runCtx.Err() == nil {
+		conn, err := c.dialer.dial(runCtx)
+		if err != nil {
+			return nil
+		}
+
+		c.serveConn(runCtx, wsinternal.NewConn(conn, c.options.writeTimeout))
+	}
+
+	return nil
+}
+
+// Shutdown 关闭连接并停止重连，等待中的调用以 entity.ErrNetwork 失败.
+func (c *WSActionClient) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	c.mu.Unlock()
+
+	if conn := c.holder.Current(); conn != nil {
+		conn.Close(errWSClientClosed)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown timeout: %w", ctx.Err())
+	}
+}
+
+// HandleActionRequest 发送动作请求并等待响应，实现 dispatcher.ActionRequestHandler
+// 未连接时等待连接建立，失败的动作响应原样返回，不转换为错误.
+func (c *WSActionClient) HandleActionRequest(
+	ctx context.Context, req *entity.ActionRequest,
+) (*entity.ActionRawResponse, error) {
+	if c.options.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.options.timeout)
+		defer cancel()
+	}
+
+	conn, err := c.holder.Wait(ctx, c.done, errWSClientClosed)
+	if err != nil {
+		return nil, fmt.Errorf("websocket call: %w", err)
+	}
+
+	resp, err := conn.Call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("websocket call: %w", err)
+	}
+
+	return resp, nil
+}
+
+// serveConn 读取连接上的响应并交付给等待中的调用，连接断开或 ctx 取消时返回.
+func (c *WSActionClient) serveConn(ctx context.Context, conn *wsinternal.Conn) {
+	c.holder.Set(conn)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Raw().Close()
+		case <-stop:
+		}
+	}()
+
+	for {
+		_, data, err := conn.Raw().ReadMessage()
+		if err != nil {
+			c.holder.Clear(conn)
+			conn.Close(err)
+
+			return
+		}
+
+		// Universal 连接上的事件等非响应消息直接忽略
+		conn.Resolve(data)
+	}
+}
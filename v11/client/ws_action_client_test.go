@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+// newWSActionServer 启动正向 WebSocket 测试服务，每个连接交由 serve 处理.
+func newWSActionServer(t *testing.T, serve func(conn *websocket.Conn, r *http.Request)) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(_ *http.Request) bool { return true },
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		serve(conn, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func startWSActionClient(t *testing.T, url string, opts ...WSActionClientOption) *WSActionClient {
+	t.Helper()
+
+	opts = append([]WSActionClientOption{WithWSActionReconnectInterval(10 * time.Millisecond)}, opts...)
+
+	c, err := NewWSActionClient(url, opts...)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_ = c.Start(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	return c
+}
+
+func readActionRequest(t *testing.T, conn *websocket.Conn) (*entity.ActionRequestEnvelope, bool) {
+	t.Helper()
+
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		return nil, false
+	}
+
+	var env entity.ActionRequestEnvelope
+
+	require.NoError(t, json.Unmarshal(data, &env))
+
+	return &env, true
+}
+
+func writeActionResponse(conn *websocket.Conn, echo json.RawMessage, resp entity.ActionRawResponse) {
+	_ = conn.WriteJSON(&entity.ActionResponseEnvelope{ActionRawResponse: resp, Echo: echo})
+}
+
+func TestNewWSActionClient_EmptyURL_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewWSActionClient(" ")
+	require.ErrorIs(t, err, errWSURLEmpty)
+}
+
+func TestWSActionClient_ConcurrentCallsOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	authCh := make(chan string, 1)
+	url := newWSActionServer(t, func(conn *websocket.Conn, r *http.Request) {
+		authCh <- r.Header.Get("Authorization")
+
+		first, ok := readActionRequest(t, conn)
+		if !ok {
+			return
+		}
+
+		second, ok := readActionRequest(t, conn)
+		if !ok {
+			return
+		}
+
+		// 先推送一个事件，再倒序返回响应
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"post_type":"meta_event","meta_event_type":"heartbeat"}`))
+
+		for _, env := range []*entity.ActionRequestEnvelope{second, first} {
+			data, err := json.Marshal(map[string]any{"message_id": env.Params["user_id"]})
+			if err != nil {
+				return
+			}
+
+			writeActionResponse(conn, env.Echo, entity.ActionRawResponse{Status: entity.StatusOK, Data: data})
+		}
+
+		_, _, _ = conn.ReadMessage()
+	})
+	c := startWSActionClient(t, url, WithWSActionAccessToken("token"))
+
+	results := make(chan int64, 2)
+
+	for _, userId := range []int64{10001, 10002} {
+		go func() {
+			resp, err := c.SendPrivateMsg(context.Background(), &entity.SendPrivateMsgRequest{
+				UserId:  userId,
+				Message: entity.NewMessage().Text("hi").Build(),
+			})
+			if err != nil {
+				results <- 0
+
+				return
+			}
+
+			results <- resp.Data.MessageId - userId
+		}()
+	}
+
+	require.Equal(t, "Bearer token", <-authCh)
+	require.Zero(t, <-results)
+	require.Zero(t, <-results)
+}
+
+func TestWSActionClient_FailedResponse(t *testing.T) {
+	t.Parallel()
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		for {
+			env, ok := readActionRequest(t, conn)
+			if !ok {
+				return
+			}
+
+			require.Equal(t, "get_group_info", env.Action)
+			require.InDelta(t, 123, env.Params["group_id"], 0)
+
+			writeActionResponse(conn, env.Echo, entity.ActionRawResponse{
+				Status:  entity.StatusFailed,
+				Retcode: entity.RetcodeNotFound,
+				Message: "group not found",
+			})
+		}
+	})
+	c := startWSActionClient(t, url)
+
+	_, err := c.GetGroupInfo(context.Background(), &entity.GetGroupInfoRequest{GroupId: 123})
+	require.ErrorIs(t, err, entity.ErrNotFound)
+
+	var actionErr *entity.ActionError
+	require.ErrorAs(t, err, &actionErr)
+	require.Equal(t, "get_group_info", actionErr.UrlPath)
+
+	raw, err := c.HandleActionRequest(context.Background(), &entity.ActionRequest{
+		Action: "get_group_info",
+		Params: map[string]any{"group_id": 123},
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFailed, raw.Status)
+}
+
+func TestWSActionClient_Reconnect(t *testing.T) {
+	t.Parallel()
+
+	var connections atomic.Int32
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		if connections.Add(1) == 1 {
+			// 第一个连接收到请求后直接断开
+			_, _ = readActionRequest(t, conn)
+
+			return
+		}
+
+		for {
+			env, ok := readActionRequest(t, conn)
+			if !ok {
+				return
+			}
+
+			writeActionResponse(conn, env.Echo, entity.ActionRawResponse{
+				Status: entity.StatusOK,
+				Data:   json.RawMessage(`{"user_id":1,"nickname":"bot"}`),
+			})
+		}
+	})
+	c := startWSActionClient(t, url)
+
+	_, err := c.GetLoginInfo(context.Background(), &entity.GetLoginInfoRequest{})
+	require.ErrorIs(t, err, entity.ErrNetwork)
+
+	resp, err := c.GetLoginInfo(context.Background(), &entity.GetLoginInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "bot", resp.Data.Nickname)
+	require.EqualValues(t, 2, connections.Load())
+}
+
+func TestWSActionClient_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		// 只读取请求，从不响应
+		for {
+			_, ok := readActionRequest(t, conn)
+			if !ok {
+				return
+			}
+		}
+	})
+	c := startWSActionClient(t, url)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetStatus(ctx, &entity.GetStatusRequest{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWSActionClient_StartTwice(t *testing.T) {
+	t.Parallel()
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		for {
+			if _, ok := readActionRequest(t, conn); !ok {
+				return
+			}
+		}
+	})
+	c := startWSActionClient(t, url)
+
+	_, err := c.holder.Wait(context.Background(), c.done, errWSClientClosed)
+	require.NoError(t, err)
+
+	err = c.Start(context.Background())
+	require.ErrorIs(t, err, errWSClientRunning)
+}
+
+func TestWSActionClient_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewWSActionClient("ws://127.0.0.1:1", WithWSActionReconnectInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	go func() {
+		_ = c.Start(context.Background())
+	}()
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		_, err := c.GetStatus(context.Background(), &entity.GetStatusRequest{})
+		errCh <- err
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	require.NoError(t, c.Shutdown(ctx))
+
+	err = <-errCh
+	require.ErrorIs(t, err, entity.ErrNetwork)
+	require.ErrorIs(t, err, errWSClientClosed)
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// defaultWSStableDuration 连接保持超过该时长后视为稳定，下次重连从 minBackoff 重新开始退避.
+const defaultWSStableDuration = 10 * time.Second
+
+// wsDialer 建立正向 WebSocket 连接，失败时按指数退避重试
+// 退避状态在多次 dial 之间保留：连接建立后很快断开时，下次 dial 先等待当前退避间隔，
+// 避免服务端接受连接后立即关闭时形成紧密的重连循环. 同一时间只能有一个 dial 调用.
+type wsDialer struct {
+	url              string
+	header           http.Header // 额外的请求头
+	accessToken      string
+	handshakeTimeout time.Duration
+	// minBackoff 首次重试间隔，之后每次翻倍直至 maxBackoff
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// stableDuration 连接保持超过该时长后重置退避，0 表示使用 defaultWSStableDuration
+	stableDuration time.Duration
+
+	backoff     time.Duration
+	connectedAt time.Time
+}
+
+// dial 建立连接，直到成功或 ctx 取消.
+func (d *wsDialer) dial(ctx context.Context) (*websocket.Conn, error) {
+	dialer := websocket.Dialer{
+		HandshakeTimeout: d.handshakeTimeout,
+	}
+
+	headers := d.header.Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+
+	if d.accessToken != "" {
+		headers.Set("Authorization", "Bearer "+d.accessToken)
+	}
+
+	if d.connectedAt.IsZero() || time.Since(d.connectedAt) >= d.stable() {
+		d.backoff = d.minBackoff
+	} else {
+		// 上一个连接很快断开，先等待退避间隔再重连
+		err := d.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("dial context canceled: %w", ctx.Err())
+		}
+
+		conn, resp, err := dialer.DialContext(ctx, d.url, headers)
+		if err == nil {
+			_ = resp.Body.Close()
+			d.connectedAt = time.Now()
+
+			return conn, nil
+		}
+
+		err = d.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// wait 等待当前退避间隔，并将间隔翻倍.
+func (d *wsDialer) wait(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("reconnect context canceled: %w", ctx.Err())
+	case <-time.After(d.backoff):
+	}
+
+	d.backoff = min(d.backoff*2, max(d.maxBackoff, d.minBackoff))
+
+	return nil
+}
+
+func (d *wsDialer) stable() time.Duration {
+	if d.stableDuration > 0 {
+		return d.stableDuration
+	}
+
+	return defaultWSStableDuration
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSDialer_BackoffAcrossDroppedConnections(t *testing.T) {
+	t.Parallel()
+
+	// 服务端接受连接后立即关闭
+	url := newWSActionServer(t, func(*websocket.Conn, *http.Request) {})
+	dialer := wsDialer{
+		url:            url,
+		minBackoff:     20 * time.Millisecond,
+		maxBackoff:     40 * time.Millisecond,
+		stableDuration: time.Hour,
+	}
+
+	dial := func() time.Duration {
+		t.Helper()
+
+		start := time.Now()
+		conn, err := dialer.dial(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		return time.Since(start)
+	}
+
+	// 首次连接不等待，之后每次断开都按退避间隔等待，间隔翻倍直至上限
+	require.Less(t, dial(), 20*time.Millisecond)
+	require.GreaterOrEqual(t, dial(), 20*time.Millisecond)
+	require.GreaterOrEqual(t, dial(), 40*time.Millisecond)
+	require.GreaterOrEqual(t, dial(), 40*time.Millisecond)
+
+	// 连接保持超过 stableDuration 后重置退避
+	dialer.stableDuration = time.Nanosecond
+	require.Less(t, dial(), 20*time.Millisecond)
+}
+
+func TestWSDialer_ContextCanceledDuringBackoff(t *testing.T) {
+	t.Parallel()
+
+	url := newWSActionServer(t, func(*websocket.Conn, *http.Request) {})
+	dialer := wsDialer{url: url, minBackoff: time.Hour, maxBackoff: time.Hour, stableDuration: time.Hour}
+
+	conn, err := dialer.dial(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = dialer.dial(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,400 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	wsinternal "github.com/q1bksuu/onebot-go-sdk/v11/internal/ws"
+	"github.com/q1bksuu/onebot-go-sdk/v11/server"
+)
+
+var (
+	errNilEventHandler        = errors.New("event handler is nil")
+	errWSSubscriberClosed     = errors.New("websocket event subscriber closed")
+	errWSSubscriberRunning    = errors.New("websocket event subscriber already started")
+	errWSEventEndpointNoCall  = errors.New("event endpoint does not accept actions")
+	errNoQuickOperationCaller = errors.New("no action caller for quick operation")
+)
+
+const (
+	defaultWSMinBackoff = time.Second
+	defaultWSMaxBackoff = 30 * time.Second
+
+	defaultWSDispatchWorkers   = 16
+	defaultWSDispatchQueueSize = 256
+)
+
+// WSEndpoint 正向 WebSocket 端点类型.
+type WSEndpoint string
+
+const (
+	// WSEndpointUniversal 通用端点 `/`，同一连接上推送事件并接受动作请求.
+	WSEndpointUniversal WSEndpoint = "universal"
+	// WSEndpointEvent 事件端点 `/event`，只推送事件.
+	WSEndpointEvent WSEndpoint = "event"
+)
+
+// WSEventSubscriber 连接 OneBot 实现的正向 WebSocket 事件端点（`/event` 或 `/`），
+// 将收到的事件解码后交由 server.EventRequestHandler 处理，机器人只需要出站连接即可接收事件.
+// 事件由固定数量的工作 goroutine 从有界队列中取出处理，队列已满时丢弃事件，连接断开后按指数退避自动重连.
+// 处理器返回的快速操作通过 .handle_quick_operation 执行：通用端点直接使用当前连接，
+// 事件端点需要通过 WithWSEventActionCaller 提供动作调用方（例如连接 `/api` 的 WSActionClient）.
+type WSEventSubscriber struct {
+	dialer   wsDialer
+	endpoint WSEndpoint
+	handler  server.EventRequestHandler
+	options  wsEventSubscriberOptions
+	holder   *wsinternal.ConnHolder
+
+	// 控制
+	mu        sync.Mutex
+	running   bool
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+type wsEventSubscriberOptions struct {
+	accessToken      string
+	endpoint         WSEndpoint
+	minBackoff       time.Duration
+	maxBackoff       time.Duration
+	handshakeTimeout time.Duration
+	writeTimeout     time.Duration
+	workers          int
+	queueSize        int
+	actionCaller     dispatcher.ActionRequestHandler
+	errorHandler     func(error)
+}
+
+// WSEventSubscriberOption 用于配置 WSEventSubscriber 的选项函数类型.
+type WSEventSubscriberOption func(*wsEventSubscriberOptions)
+
+// WithWSEventAccessToken 设置访问令牌，连接时附加 Authorization Bearer 头.
+func WithWSEventAccessToken(token string) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.accessToken = token }
+}
+
+// WithWSEventEndpoint 指定端点类型，默认根据 URL 路径推断：以 /event 结尾为事件端点，否则为通用端点.
+func WithWSEventEndpoint(endpoint WSEndpoint) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.endpoint = endpoint }
+}
+
+// WithWSEventBackoff 设置重连退避间隔，从 minBackoff 开始每次翻倍直至 maxBackoff，默认 1 秒至 30 秒.
+func WithWSEventBackoff(minBackoff, maxBackoff time.Duration) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) {
+		o.minBackoff = minBackoff
+		o.maxBackoff = maxBackoff
+	}
+}
+
+// WithWSEventHandshakeTimeout 设置握手超时，默认 10 秒.
+func WithWSEventHandshakeTimeout(timeout time.Duration) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.handshakeTimeout = timeout }
+}
+
+// WithWSEventWriteTimeout 设置通用端点上发送动作请求的写入超时（可选），默认 0.
+func WithWSEventWriteTimeout(timeout time.Duration) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.writeTimeout = timeout }
+}
+
+// WithWSEventDispatch 设置处理事件的工作 goroutine 数与等待处理的事件队列长度，默认 16 与 256
+// workers 为 1 时按接收顺序串行处理事件；队列已满时丢弃新事件，并以 server.ErrEventQueueFull 报告给错误回调.
+func WithWSEventDispatch(workers, queueSize int) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) {
+		o.workers = workers
+		o.queueSize = queueSize
+	}
+}
+
+// WithWSEventActionCaller 设置执行快速操作的动作调用方，未设置时通用端点使用当前连接.
+func WithWSEventActionCaller(caller dispatcher.ActionRequestHandler) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.actionCaller = caller }
+}
+
+// WithWSEventErrorHandler 设置错误回调，用于接收事件解码、事件处理与快速操作执行中的错误.
+func WithWSEventErrorHandler(fn func(error)) WSEventSubscriberOption {
+	return func(o *wsEventSubscriberOptions) { o.errorHandler = fn }
+}
+
+// NewWSEventSubscriber 创建正向 WebSocket 事件订阅器，调用 Start 后开始连接.
+func NewWSEventSubscriber(
+	rawURL string, handler server.EventRequestHandler, opts ...WSEventSubscriberOption,
+) (*WSEventSubscriber, error) {
+	if strings.TrimSpace(rawURL) == "" {
+		return nil, fmt.Errorf("%w", errWSURLEmpty)
+	}
+
+	if handler == nil {
+		return nil, fmt.Errorf("%w", errNilEventHandler)
+	}
+
+	options := wsEventSubscriberOptions{
+		minBackoff:       defaultWSMinBackoff,
+		maxBackoff:       defaultWSMaxBackoff,
+		handshakeTimeout: defaultWSHandshakeTimeout,
+		workers:          defaultWSDispatchWorkers,
+		queueSize:        defaultWSDispatchQueueSize,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	options.workers = max(options.workers, 1)
+	options.queueSize = max(options.queueSize, 0)
+
+	endpoint := options.endpoint
+	if endpoint == "" {
+		endpoint = inferWSEndpoint(rawURL)
+	}
+
+	return &WSEventSubscriber{
+		dialer: wsDialer{
+			url:              rawURL,
+			accessToken:      options.accessToken,
+			handshakeTimeout: options.handshakeTimeout,
+			minBackoff:       options.minBackoff,
+			maxBackoff:       options.maxBackoff,
+		},
+		endpoint: endpoint,
+		handler:  handler,
+		options:  options,
+		holder:   wsinternal.NewConnHolder(),
+		done:     make(chan struct{}),
+	}, nil
+}
+
+// Endpoint 返回端点类型.
+func (s *WSEventSubscriber) Endpoint() WSEndpoint {
+	return s.endpoint
+}
+
+// Start 建立连接并处理事件，断线后自动重连，直到 ctx 取消或调用 Shutdown
+// 同一时间只能有一个 Start 在运行，Start 返回后可以再次启动；已调用 Shutdown 的订阅器不能再次启动.
+func (s *WSEventSubscriber) Start(ctx context.Context) error {
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+
+		return fmt.Errorf("%w", errWSSubscriberClosed)
+	default:
+	}
+
+	if s.running {
+		s.mu.Unlock()
+
+		return fmt.Errorf("%w", errWSSubscriberRunning)
+	}
+
+	s.running = true
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+		s.wg.Done()
+	}()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// 工作 goroutine 在队列关闭并处理完剩余事件后退出
+	queue := make(chan entity.Event, s.options.queueSize)
+	defer close(queue)
+
+	s.startWorkers(runCtx, queue)
+
+	go func() {
+		select {
+		case <-s.done:
+			cancel()
+		case <-runCtx.Done():
+		}
+	}()
+
+	for runCtx.Err() == nil {
+		conn, err := s.dialer.dial(runCtx)
+		if err != nil {
+			return nil
+		}
+
+		s.serveConn(runCtx, wsinternal.NewConn(conn, s.options.writeTimeout), queue)
+	}
+
+	return nil
+}
+
+// Shutdown 关闭连接并停止重连，等待正在处理的事件结束.
+func (s *WSEventSubscriber) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+	s.mu.Unlock()
+
+	if conn := s.holder.Current(); conn != nil {
+		conn.Close(errWSSubscriberClosed)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("shutdown timeout: %w", ctx.Err())
+	}
+}
+
+// HandleActionRequest 通过通用端点的当前连接调用动作，实现 dispatcher.ActionRequestHandler
+// 事件端点不接受动作请求.
+func (s *WSEventSubscriber) HandleActionRequest(
+	ctx context.Context, req *entity.ActionRequest,
+) (*entity.ActionRawResponse, error) {
+	if s.endpoint != WSEndpointUniversal {
+		return nil, fmt.Errorf("%w: %s", errWSEventEndpointNoCall, s.endpoint)
+	}
+
+	conn, err := s.holder.Wait(ctx, s.done, errWSSubscriberClosed)
+	if err != nil {
+		return nil, fmt.Errorf("websocket call: %w", err)
+	}
+
+	resp, err := conn.Call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("websocket call: %w", err)
+	}
+
+	return resp, nil
+}
+
+// serveConn 读取连接上的消息，动作响应交付给等待中的调用，其余消息作为事件放入 queue
+// 读取循环不等待事件处理，处理器通过同一连接调用动作时也能收到响应.
+func (s *WSEventSubscriber) serveConn(ctx context.Context, conn *wsinternal.Conn, queue chan<- entity.Event) {
+	s.holder.Set(conn)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Raw().Close()
+		case <-stop:
+		}
+	}()
+
+	for {
+		_, data, err := conn.Raw().ReadMessage()
+		if err != nil {
+			s.holder.Clear(conn)
+			conn.Close(err)
+
+			return
+		}
+
+		if s.endpoint == WSEndpointUniversal && conn.Resolve(data) {
+			continue
+		}
+
+		event, err := entity.ParseEvent(data)
+		if err != nil {
+			s.reportError(fmt.Errorf("%w: decode event: %w", entity.ErrProtocol, err))
+
+			continue
+		}
+
+		select {
+		case queue <- event:
+		default:
+			s.reportError(fmt.Errorf("%w: dispatch %s event", server.ErrEventQueueFull, event.GetPostType()))
+		}
+	}
+}
+
+// startWorkers 启动处理 queue 中事件的工作 goroutine.
+func (s *WSEventSubscriber) startWorkers(ctx context.Context, queue <-chan entity.Event) {
+	for range s.options.workers {
+		s.wg.Add(1)
+
+		go func() {
+			defer s.wg.Done()
+
+			for event := range queue {
+				s.handleEvent(ctx, event)
+			}
+		}()
+	}
+}
+
+func (s *WSEventSubscriber) handleEvent(ctx context.Context, event entity.Event) {
+	quickOp, err := s.handler.HandleEvent(ctx, event)
+	if err != nil {
+		s.reportError(fmt.Errorf("handle event: %w", err))
+
+		return
+	}
+
+	if quickOp == nil || quickOp.IsEmpty() {
+		return
+	}
+
+	caller := s.quickOperationCaller()
+	if caller == nil {
+		s.reportError(fmt.Errorf("%w: %s endpoint", errNoQuickOperationCaller, s.endpoint))
+
+		return
+	}
+
+	err = server.CallQuickOperation(ctx, caller, event, quickOp)
+	if err != nil {
+		s.reportError(err)
+	}
+}
+
+func (s *WSEventSubscriber) quickOperationCaller() dispatcher.ActionRequestHandler {
+	if s.options.actionCaller != nil {
+		return s.options.actionCaller
+	}
+
+	if s.endpoint == WSEndpointUniversal {
+		return s
+	}
+
+	return nil
+}
+
+func (s *WSEventSubscriber) reportError(err error) {
+	if s.options.errorHandler != nil {
+		s.options.errorHandler(err)
+	}
+}
+
+// inferWSEndpoint 根据 URL 路径推断端点类型，以 /event 结尾为事件端点，否则为通用端点.
+func inferWSEndpoint(rawURL string) WSEndpoint {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return WSEndpointUniversal
+	}
+
+	if strings.HasSuffix(strings.TrimRight(u.Path, "/"), "/event") {
+		return WSEndpointEvent
+	}
+
+	return WSEndpointUniversal
+}
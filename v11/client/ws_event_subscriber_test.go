@@ -0,0 +1,270 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/server"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPrivateMessageEvent = `{"time":1,"self_id":10000,"post_type":"message","message_type":"private",` +
+		`"sub_type":"friend","message_id":1,"user_id":20000,"message":"ping","raw_message":"ping","font":0}`
+	testGroupMessageEvent = `{"time":1,"self_id":10000,"post_type":"message","message_type":"group",` +
+		`"sub_type":"normal","message_id":2,"group_id":30000,"user_id":20000,"message":"ping",` +
+		`"raw_message":"ping","font":0}`
+)
+
+func startWSEventSubscriber(
+	t *testing.T, url string, handler server.EventRequestHandler, opts ...WSEventSubscriberOption,
+) *WSEventSubscriber {
+	t.Helper()
+
+	opts = append([]WSEventSubscriberOption{WithWSEventBackoff(5*time.Millisecond, 20*time.Millisecond)}, opts...)
+
+	s, err := NewWSEventSubscriber(url, handler, opts...)
+	require.NoError(t, err)
+
+	go func() {
+		_ = s.Start(context.Background())
+	}()
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = s.Shutdown(ctx)
+	})
+
+	return s
+}
+
+// eventCollector 将收到的事件写入通道，并返回固定的快速操作.
+func eventCollector(events chan<- entity.Event, quickOp entity.QuickOperation) server.EventRequestHandler {
+	return server.EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+		events <- event
+
+		return quickOp, nil
+	})
+}
+
+func TestNewWSEventSubscriber_Validate(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewWSEventSubscriber("", eventCollector(nil, nil))
+	require.ErrorIs(t, err, errWSURLEmpty)
+
+	_, err = NewWSEventSubscriber("ws://127.0.0.1/event", nil)
+	require.ErrorIs(t, err, errNilEventHandler)
+}
+
+func TestInferWSEndpoint(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]WSEndpoint{
+		"ws://127.0.0.1:6700":            WSEndpointUniversal,
+		"ws://127.0.0.1:6700/":           WSEndpointUniversal,
+		"ws://127.0.0.1:6700/event":      WSEndpointEvent,
+		"ws://127.0.0.1:6700/bot/event/": WSEndpointEvent,
+		"ws://127.0.0.1:6700/events":     WSEndpointUniversal,
+		"://bad":                         WSEndpointUniversal,
+	}
+
+	for rawURL, want := range cases {
+		require.Equal(t, want, inferWSEndpoint(rawURL), rawURL)
+	}
+}
+
+func TestWSEventSubscriber_EventEndpoint(t *testing.T) {
+	t.Parallel()
+
+	authCh := make(chan string, 1)
+	url := newWSActionServer(t, func(conn *websocket.Conn, r *http.Request) {
+		authCh <- r.Header.Get("Authorization")
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(testPrivateMessageEvent))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	events := make(chan entity.Event, 1)
+	errs := make(chan error, 1)
+	s := startWSEventSubscriber(t, url+"/event",
+		eventCollector(events, &entity.MessageQuickOperation{Reply: entity.NewMessage().Text("pong").Build()}),
+		WithWSEventAccessToken("token"),
+		WithWSEventErrorHandler(func(err error) { errs <- err }),
+	)
+	require.Equal(t, WSEndpointEvent, s.Endpoint())
+
+	require.Equal(t, "Bearer token", <-authCh)
+
+	event, ok := (<-events).(*entity.PrivateMessageEvent)
+	require.True(t, ok)
+	require.Equal(t, int64(20000), event.UserId)
+
+	// 事件端点没有动作调用方，快速操作无法执行
+	require.ErrorIs(t, <-errs, errNoQuickOperationCaller)
+
+	_, err := s.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "get_status"})
+	require.ErrorIs(t, err, errWSEventEndpointNoCall)
+}
+
+func TestWSEventSubscriber_UniversalQuickOperation(t *testing.T) {
+	t.Parallel()
+
+	quickOpCh := make(chan *entity.ActionRequestEnvelope, 1)
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(testGroupMessageEvent))
+
+		env, ok := readActionRequest(t, conn)
+		if !ok {
+			return
+		}
+
+		quickOpCh <- env
+
+		writeActionResponse(conn, env.Echo, entity.ActionRawResponse{Status: entity.StatusOK})
+		_, _, _ = conn.ReadMessage()
+	})
+
+	events := make(chan entity.Event, 1)
+	errs := make(chan error, 1)
+	s := startWSEventSubscriber(t, url,
+		eventCollector(events, &entity.MessageQuickOperation{Reply: entity.NewMessage().Text("pong").Build()}),
+		WithWSEventErrorHandler(func(err error) { errs <- err }),
+	)
+	require.Equal(t, WSEndpointUniversal, s.Endpoint())
+
+	_, ok := (<-events).(*entity.GroupMessageEvent)
+	require.True(t, ok)
+
+	env := <-quickOpCh
+	require.Equal(t, entity.HandleQuickOperationAction, env.Action)
+	require.NotEmpty(t, env.Echo)
+
+	data, err := json.Marshal(env.Params)
+	require.NoError(t, err)
+
+	var req entity.HandleQuickOperationRequest
+
+	require.NoError(t, json.Unmarshal(data, &req))
+	require.InDelta(t, 30000, req.Context["group_id"], 0)
+	require.Equal(t, []any{map[string]any{"type": "text", "data": map[string]any{"text": "pong"}}}, req.Operation["reply"])
+
+	select {
+	case err := <-errs:
+		require.NoError(t, err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWSEventSubscriber_ActionCallerAndDecodeError(t *testing.T) {
+	t.Parallel()
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`not json`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(testPrivateMessageEvent))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	called := make(chan string, 1)
+	caller := dispatcher.ActionRequestHandlerFunc(
+		func(_ context.Context, req *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			called <- req.Action
+
+			return &entity.ActionRawResponse{Status: entity.StatusOK}, nil
+		},
+	)
+
+	events := make(chan entity.Event, 1)
+	errs := make(chan error, 1)
+	startWSEventSubscriber(t, url+"/event",
+		eventCollector(events, &entity.MessageQuickOperation{Reply: entity.NewMessage().Text("pong").Build()}),
+		WithWSEventActionCaller(caller),
+		WithWSEventErrorHandler(func(err error) { errs <- err }),
+	)
+
+	require.ErrorIs(t, <-errs, entity.ErrProtocol)
+	require.IsType(t, &entity.PrivateMessageEvent{}, <-events)
+	require.Equal(t, entity.HandleQuickOperationAction, <-called)
+}
+
+func TestWSEventSubscriber_Reconnect(t *testing.T) {
+	t.Parallel()
+
+	var connections atomic.Int32
+
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		if connections.Add(1) == 1 {
+			// 第一个连接直接断开
+			return
+		}
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(testPrivateMessageEvent))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	events := make(chan entity.Event, 1)
+	startWSEventSubscriber(t, url+"/event", eventCollector(events, nil))
+
+	require.IsType(t, &entity.PrivateMessageEvent{}, <-events)
+	require.EqualValues(t, 2, connections.Load())
+}
+
+func TestWSEventSubscriber_SerialDispatchAndQueueFull(t *testing.T) {
+	t.Parallel()
+
+	const sent = 5
+
+	blocked := make(chan struct{})
+	url := newWSActionServer(t, func(conn *websocket.Conn, _ *http.Request) {
+		for i := range sent {
+			event := strings.Replace(testPrivateMessageEvent, `"message_id":1`, `"message_id":`+strconv.Itoa(i), 1)
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(event))
+
+			if i == 0 {
+				<-blocked
+			}
+		}
+
+		_, _, _ = conn.ReadMessage()
+	})
+
+	// 第一个事件阻塞处理器，队列只能容纳 2 个事件，其余事件被丢弃
+	release := make(chan struct{})
+	handled := make(chan int64, sent)
+	errs := make(chan error, sent)
+	handler := server.EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+		msg, _ := event.(*entity.PrivateMessageEvent)
+		if msg.MessageId == 0 {
+			close(blocked)
+			<-release
+		}
+
+		handled <- msg.MessageId
+
+		return nil, nil //nolint:nilnil // 测试处理器不返回快速操作
+	})
+	s := startWSEventSubscriber(t, url+"/event", handler,
+		WithWSEventDispatch(1, 2),
+		WithWSEventErrorHandler(func(err error) { errs <- err }),
+	)
+
+	require.ErrorIs(t, <-errs, server.ErrEventQueueFull)
+	require.ErrorIs(t, <-errs, server.ErrEventQueueFull)
+	close(release)
+
+	// 串行处理，按接收顺序完成
+	require.Equal(t, []int64{0, 1, 2}, []int64{<-handled, <-handled, <-handled})
+
+	require.ErrorIs(t, s.Start(context.Background()), errWSSubscriberRunning)
+}
@@ -25,7 +25,13 @@ func main() {
 			"",
 			"output go file for server bindings",
 		)
-		httpClientActionsOutput = flag.String("http-client-actions-output", "", "output go file for http client")
+		httpClientActionsOutput   = flag.String("http-client-actions-output", "", "output go file for http client")
+		actionClientActionsOutput = flag.String(
+			"action-client-actions-output",
+			"",
+			"output go file for action handler based client",
+		)
+		clientAPIOutput = flag.String("client-api-output", "", "output go file for client api interface")
 	)
 
 	flag.Parse()
@@ -43,9 +49,10 @@ func main() {
 	log.Printf("using config: %s\n", *configPath)
 
 	for _, genInfo := range []struct {
-		template *template.Template
-		output   *string
-		desc     string
+		template   *template.Template
+		output     *string
+		desc       string
+		clientType string
 	}{
 		{
 			template: httpServerActionsRegisterTpl,
@@ -53,9 +60,21 @@ func main() {
 			desc:     "http server actions register output",
 		},
 		{
-			template: httpClientActionsTpl,
-			output:   httpClientActionsOutput,
-			desc:     "http client actions output",
+			template:   httpClientActionsTpl,
+			output:     httpClientActionsOutput,
+			desc:       "http client actions output",
+			clientType: httpClientType,
+		},
+		{
+			template:   httpClientActionsTpl,
+			output:     actionClientActionsOutput,
+			desc:       "action client actions output",
+			clientType: actionClientType,
+		},
+		{
+			template: clientAPITpl,
+			output:   clientAPIOutput,
+			desc:     "client api output",
 		},
 	} {
 		if genInfo.output != nil && strings.TrimSpace(*genInfo.output) != "" {
@@ -67,6 +86,8 @@ func main() {
 				exitErr(genInfo.desc+": mkdir output dir failed", err)
 			}
 
+			cfg.ClientType = genInfo.clientType
+
 			code, err := render(cfg, genInfo.template)
 			if err != nil {
 				exitErr(genInfo.desc+": render failed", err)
@@ -100,6 +121,16 @@ func loadConfig(path string) (*Config, error) {
 		return nil, fmt.Errorf("unmarshal yaml: %w", err)
 	}
 
+	if strings.TrimSpace(cfg.RegisterFunc) == "" {
+		cfg.RegisterFunc = defaultRegisterFunc
+	}
+
+	if strings.TrimSpace(cfg.ClientAPI.Name) == "" {
+		cfg.ClientAPI.Name = defaultClientAPIName
+	}
+
+	cfg.Source = configSourceDir + "/" + filepath.Base(abs)
+
 	return &cfg, nil
 }
 
@@ -191,6 +222,18 @@ func exitErr(stage string, err error) {
 	os.Exit(1)
 }
 
+const (
+	// defaultRegisterFunc 未配置 register_func 时使用的注册函数名.
+	defaultRegisterFunc = "RegisterGenerated"
+	// defaultClientAPIName 未配置 client_api.name 时使用的接口名.
+	defaultClientAPIName = "API"
+	// configSourceDir 配置文件相对于模块根目录的位置，用于生成代码头部注释.
+	configSourceDir = "cmd/bindings-gen"
+	// httpClientType / actionClientType 生成动作方法的客户端类型.
+	httpClientType   = "HTTPClient"
+	actionClientType = "ActionClient"
+)
+
 var (
 	errNoGroupsConfigured     = errors.New("no groups configured")
 	errInvalidGroupName       = errors.New("invalid group name")
@@ -205,7 +248,10 @@ var (
 	httpServerActionsRegisterTplText string
 	//go:embed templates/http_client_actions.gohtml
 	httpClientActionsTplText string
+	//go:embed templates/client_api.gohtml
+	clientAPITplText string
 
 	httpServerActionsRegisterTpl = template.Must(template.New("server").Parse(httpServerActionsRegisterTplText))
 	httpClientActionsTpl         = template.Must(template.New("client-actions").Parse(httpClientActionsTplText))
+	clientAPITpl                 = template.Must(template.New("client-api").Parse(clientAPITplText))
 )
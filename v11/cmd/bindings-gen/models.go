@@ -4,6 +4,14 @@ package main
 type Config struct {
 	Groups          []Group         `yaml:"groups"`
 	CombinedService CombinedService `yaml:"combined_service"`
+	// ClientAPI 生成的客户端动作接口.
+	ClientAPI ClientAPI `yaml:"client_api"`
+	// RegisterFunc 生成的注册函数名，默认 RegisterGenerated.
+	RegisterFunc string `yaml:"register_func"`
+	// Source 生成代码头部注释中的配置来源，由生成器根据配置路径填充.
+	Source string `yaml:"-"`
+	// ClientType 生成客户端动作方法的接收者类型，由生成器根据输出目标填充.
+	ClientType string `yaml:"-"`
 }
 
 type CombinedService struct {
@@ -11,6 +19,13 @@ type CombinedService struct {
 	Desc string `yaml:"desc"`
 }
 
+// ClientAPI 客户端动作接口配置，各传输层的客户端均实现该接口.
+type ClientAPI struct {
+	// Name 接口名，默认 API.
+	Name string `yaml:"name"`
+	Desc string `yaml:"desc"`
+}
+
 // Group 表示一组业务接口，可生成独立 Service.
 type Group struct {
 	Name        string   `yaml:"name"`
@@ -32,6 +47,6 @@ type Action struct {
 	Response string `yaml:"response"`
 	// HTTPMethod 可选，默认 POST，可指定 GET/POST.
 	HTTPMethod string `yaml:"http_method"`
-	// Path 可选，默认 "/{action}".
+	// Path 可选，默认 "/{action}"，仅 HTTP 客户端使用，WebSocket 客户端始终使用 Action.
 	Path string `yaml:"path"`
 }
@@ -51,7 +51,9 @@ type templateField struct {
 }
 
 type templateStruct struct {
-	Fields []templateField
+	Name      string
+	HasOrigin bool
+	Fields    []templateField
 }
 
 type structDef struct {
@@ -60,6 +62,13 @@ type structDef struct {
 	structType *ast.StructType
 }
 
+const (
+	// originFieldName 用于保存原始字段的未导出字段名，类型必须为 map[string]any.
+	originFieldName = "origin"
+	// originUtilImport 生成的 origin 相关方法依赖的工具包.
+	originUtilImport = "github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
 type Generator struct {
 	filename          string
 	fileSet           *token.FileSet
@@ -114,19 +123,24 @@ func (g *Generator) Generate(targetTypes []string) (string, error) {
 	for _, sd := range structDefs {
 		fields := g.extractFields(sd.structType, sd.name, sd.typeParams)
 		tmplFields := g.buildTemplateFields(fields)
-		tmplStructs = append(tmplStructs, templateStruct{Fields: tmplFields})
+		tmplStructs = append(tmplStructs, templateStruct{
+			Name: sd.name,
+			// 泛型结构体无法声明同名别名类型，不生成 origin 相关方法
+			HasOrigin: sd.typeParams == "" && g.hasOriginField(sd.structType),
+			Fields:    tmplFields,
+		})
 	}
 
 	imports := g.collectImports(tmplStructs)
 
 	data := struct {
-		Package string
-		Imports []string
-		Structs []templateStruct
+		Package      string
+		ImportGroups [][]string
+		Structs      []templateStruct
 	}{
-		Package: g.astFile.Name.Name,
-		Imports: imports,
-		Structs: tmplStructs,
+		Package:      g.astFile.Name.Name,
+		ImportGroups: groupImports(imports),
+		Structs:      tmplStructs,
 	}
 
 	var buf bytes.Buffer
@@ -188,6 +202,31 @@ func (g *Generator) findStructs(targetTypes []string) []structDef {
 	return result
 }
 
+// hasOriginField 判断结构体是否声明了 `origin map[string]any` 字段.
+// 声明了该字段的结构体会额外生成 GetOrigin / SetOrigin / UnmarshalJSON / MarshalJSON 方法，
+// 用于无损保留反序列化时的全部原始字段.
+// MarshalJSON 使用值接收者，结构体值与指针序列化时都会保留未声明字段.
+func (g *Generator) hasOriginField(structType *ast.StructType) bool {
+	if structType.Fields == nil {
+		return false
+	}
+
+	for _, field := range structType.Fields.List {
+		mapType, ok := field.Type.(*ast.MapType)
+		if !ok || g.typeToString(mapType) != "map[string]any" {
+			continue
+		}
+
+		for _, name := range field.Names {
+			if name.Name == originFieldName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (g *Generator) buildTemplateFields(fields []fieldInfo) []templateField {
 	res := make([]templateField, 0, len(fields))
 	for _, field := range fields {
@@ -292,6 +331,11 @@ func (g *Generator) collectImports(structs []templateStruct) []string {
 
 	for _, st := range structs {
 		g.collectStructImports(st, aliasToPath, importsSet)
+
+		if st.HasOrigin {
+			importsSet["fmt"] = struct{}{}
+			importsSet[originUtilImport] = struct{}{}
+		}
 	}
 
 	if len(importsSet) == 0 {
@@ -308,6 +352,30 @@ func (g *Generator) collectImports(structs []templateStruct) []string {
 	return imports
 }
 
+// groupImports 将导入路径分为标准库与第三方库两组.
+func groupImports(imports []string) [][]string {
+	var std, others []string
+
+	for _, imp := range imports {
+		first, _, _ := strings.Cut(imp, "/")
+		if strings.Contains(first, ".") {
+			others = append(others, imp)
+		} else {
+			std = append(std, imp)
+		}
+	}
+
+	var groups [][]string
+
+	for _, group := range [][]string{std, others} {
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
 func (g *Generator) buildAliasToPathMap() map[string]string {
 	aliasToPath := make(map[string]string)
 
@@ -22,4 +22,6 @@ type Event struct {
 	Key    string `yaml:"key"`
 	Desc   string `yaml:"desc"`
 	Type   string `yaml:"type"`
+	// QuickOperation 事件支持的快速操作类型，为空表示该事件不支持快速操作
+	QuickOperation string `yaml:"quick_operation"`
 }
@@ -44,7 +44,7 @@ func APIFuncToActionHandler[Req any, Resp any](
 	return func(ctx context.Context, params map[string]any) (*entity.ActionRawResponse, error) {
 		var req Req
 
-		err := util.JsonTagMapping(params, &req)
+		err := util.JsonTagMapping(params, &req, util.JsonUnmarshalerDecodeHook)
 		if err != nil {
 			return nil, fmt.Errorf("bind params to request failed: %w", err)
 		}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	// ErrActionNotFound 表示 action 未注册 / 不存在，应映射为 404.
 	ErrActionNotFound = errors.New("action not found")
+	// ErrInvalidQuickOperation 表示 .handle_quick_operation 的参数不合法.
+	ErrInvalidQuickOperation = errors.New("invalid quick operation")
 )
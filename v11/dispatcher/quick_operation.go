@@ -0,0 +1,250 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// defaultQuickOperationBanDuration 快速操作禁言的默认时长（秒）.
+const defaultQuickOperationBanDuration = 30 * 60
+
+// quickOperationCall 快速操作转换得到的具体动作.
+type quickOperationCall struct {
+	action string
+	params any
+}
+
+// QuickOperationHandler 返回 .handle_quick_operation 动作的处理器
+// 根据事件上下文将快速操作转换为 send_msg、delete_msg、set_group_kick、set_group_ban 等具体动作，
+// 并按顺序交由 target 执行，通常 target 为注册了这些动作的 Dispatcher 本身:
+//
+//	d.Register(entity.HandleQuickOperationAction, dispatcher.QuickOperationHandler(d))
+func QuickOperationHandler(target ActionRequestHandler) ActionHandler {
+	return func(ctx context.Context, params map[string]any) (*entity.ActionRawResponse, error) {
+		calls, err := quickOperationCalls(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, call := range calls {
+			callParams, err := util.JsonStructToMap(call.params)
+			if err != nil {
+				return nil, fmt.Errorf("encode %s params: %w", call.action, err)
+			}
+
+			resp, err := target.HandleActionRequest(ctx, &entity.ActionRequest{Action: call.action, Params: callParams})
+			if err != nil {
+				return nil, fmt.Errorf("quick operation %s: %w", call.action, err)
+			}
+
+			if resp != nil && resp.Status == entity.StatusFailed {
+				return resp, nil
+			}
+		}
+
+		return &entity.ActionRawResponse{Status: entity.StatusOK, Retcode: entity.RetcodeSuccess}, nil
+	}
+}
+
+// quickOperationCalls 解析 .handle_quick_operation 参数并转换为具体动作.
+func quickOperationCalls(params map[string]any) ([]quickOperationCall, error) {
+	var req entity.HandleQuickOperationRequest
+
+	err := util.JsonTagMapping(params, &req)
+	if err != nil || req.Context == nil {
+		return nil, fmt.Errorf("%w: context is required", ErrInvalidQuickOperation)
+	}
+
+	contextData, err := json.Marshal(req.Context)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuickOperation, err)
+	}
+
+	event, err := entity.ParseEvent(contextData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidQuickOperation, err)
+	}
+
+	switch ev := event.(type) {
+	case *entity.PrivateMessageEvent:
+		var op entity.MessageQuickOperation
+
+		err = decodeQuickOperation(req.Operation, &op)
+
+		return privateMessageQuickOperationCalls(ev, &op), err
+	case *entity.GroupMessageEvent:
+		var op entity.MessageQuickOperation
+
+		err = decodeQuickOperation(req.Operation, &op)
+
+		return groupMessageQuickOperationCalls(ev, &op), err
+	case *entity.FriendRequestEvent:
+		var op entity.FriendRequestQuickOperation
+
+		err = decodeQuickOperation(req.Operation, &op)
+
+		return friendRequestQuickOperationCalls(ev, &op), err
+	case *entity.GroupRequestEvent:
+		var op entity.GroupRequestQuickOperation
+
+		err = decodeQuickOperation(req.Operation, &op)
+
+		return groupRequestQuickOperationCalls(ev, &op), err
+	default:
+		// 其他事件不支持快速操作
+		return nil, nil
+	}
+}
+
+func decodeQuickOperation(operation map[string]any, dest any) error {
+	data, err := json.Marshal(operation)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidQuickOperation, err)
+	}
+
+	err = json.Unmarshal(data, dest)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidQuickOperation, err)
+	}
+
+	return nil
+}
+
+func privateMessageQuickOperationCalls(
+	ev *entity.PrivateMessageEvent,
+	op *entity.MessageQuickOperation,
+) []quickOperationCall {
+	if op.Reply == nil {
+		return nil
+	}
+
+	return []quickOperationCall{{action: "send_msg", params: &entity.SendMsgRequest{
+		MessageType: entity.MessageTypePrivate,
+		UserId:      ev.UserId,
+		Message:     op.Reply,
+		AutoEscape:  op.GetAutoEscape(),
+	}}}
+}
+
+func groupMessageQuickOperationCalls(
+	ev *entity.GroupMessageEvent,
+	op *entity.MessageQuickOperation,
+) []quickOperationCall {
+	var calls []quickOperationCall
+
+	anonymous := ev.Anonymous != nil
+
+	if op.Reply != nil {
+		reply, autoEscape := op.Reply, op.GetAutoEscape()
+		// at_sender 默认为 true，匿名消息无法 at
+		if (op.AtSender == nil || *op.AtSender) && !anonymous {
+			reply, autoEscape = prependAtSender(reply, autoEscape, ev.UserId)
+		}
+
+		calls = append(calls, quickOperationCall{action: "send_msg", params: &entity.SendMsgRequest{
+			MessageType: entity.MessageTypeGroup,
+			GroupId:     ev.GroupId,
+			Message:     reply,
+			AutoEscape:  autoEscape,
+		}})
+	}
+
+	if op.GetDelete() {
+		calls = append(calls, quickOperationCall{action: "delete_msg", params: &entity.DeleteMsgRequest{
+			MessageId: ev.MessageId,
+		}})
+	}
+
+	if op.GetKick() && !anonymous {
+		calls = append(calls, quickOperationCall{action: "set_group_kick", params: &entity.SetGroupKickRequest{
+			GroupId: ev.GroupId,
+			UserId:  ev.UserId,
+		}})
+	}
+
+	if op.GetBan() {
+		duration := int64(defaultQuickOperationBanDuration)
+		if op.BanDuration != nil {
+			duration = *op.BanDuration
+		}
+
+		if anonymous {
+			calls = append(calls, quickOperationCall{
+				action: "set_group_anonymous_ban",
+				params: &entity.SetGroupAnonymousBanRequest{
+					GroupId:       ev.GroupId,
+					Anonymous:     ev.Anonymous,
+					AnonymousFlag: ev.Anonymous.Flag,
+					Duration:      duration,
+				},
+			})
+		} else {
+			calls = append(calls, quickOperationCall{action: "set_group_ban", params: &entity.SetGroupBanRequest{
+				GroupId:  ev.GroupId,
+				UserId:   ev.UserId,
+				Duration: duration,
+			}})
+		}
+	}
+
+	return calls
+}
+
+// prependAtSender 在回复开头添加 at 发送者，返回新的消息及其 auto_escape 设置.
+func prependAtSender(reply *entity.MessageValue, autoEscape bool, userId int64) (*entity.MessageValue, bool) {
+	if reply.Type == entity.MessageValueTypeString && !autoEscape {
+		at, _ := entity.NewMessage().At(userId).Text(" ").BuildString()
+
+		return &entity.MessageValue{
+			Type:        entity.MessageValueTypeString,
+			StringValue: at.StringValue + reply.StringValue,
+		}, false
+	}
+
+	builder := entity.NewMessage().At(userId).Text(" ")
+	if reply.Type == entity.MessageValueTypeString {
+		// 纯文本回复转换为消息段数组，auto_escape 不再需要
+		builder.Text(reply.StringValue)
+	} else {
+		for _, segment := range reply.ArrayValue {
+			builder.Append(segment.GetData())
+		}
+	}
+
+	return builder.Build(), false
+}
+
+func friendRequestQuickOperationCalls(
+	ev *entity.FriendRequestEvent,
+	op *entity.FriendRequestQuickOperation,
+) []quickOperationCall {
+	if op.Approve == nil {
+		return nil
+	}
+
+	return []quickOperationCall{{action: "set_friend_add_request", params: &entity.SetFriendAddRequestRequest{
+		Flag:    ev.Flag,
+		Approve: *op.Approve,
+		Remark:  op.Remark,
+	}}}
+}
+
+func groupRequestQuickOperationCalls(
+	ev *entity.GroupRequestEvent,
+	op *entity.GroupRequestQuickOperation,
+) []quickOperationCall {
+	if op.Approve == nil {
+		return nil
+	}
+
+	return []quickOperationCall{{action: "set_group_add_request", params: &entity.SetGroupAddRequestRequest{
+		Flag:    ev.Flag,
+		SubType: entity.SetGroupAddRequestSubType(ev.SubType),
+		Approve: *op.Approve,
+		Reason:  op.Reason,
+	}}}
+}
@@ -0,0 +1,216 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedCall struct {
+	action string
+	req    any
+}
+
+func recordAction[Req any](d *Dispatcher, calls *[]recordedCall, action string) {
+	d.Register(action, APIFuncToActionHandler(
+		func(_ context.Context, req *Req) (*entity.ActionResponse[struct{}], error) {
+			*calls = append(*calls, recordedCall{action: action, req: req})
+
+			return &entity.ActionResponse[struct{}]{Status: entity.StatusOK}, nil
+		}))
+}
+
+func newQuickOperationDispatcher(calls *[]recordedCall) *Dispatcher {
+	d := NewDispatcher()
+	recordAction[entity.SendMsgRequest](d, calls, "send_msg")
+	recordAction[entity.DeleteMsgRequest](d, calls, "delete_msg")
+	recordAction[entity.SetGroupKickRequest](d, calls, "set_group_kick")
+	recordAction[entity.SetGroupBanRequest](d, calls, "set_group_ban")
+	recordAction[entity.SetGroupAnonymousBanRequest](d, calls, "set_group_anonymous_ban")
+	recordAction[entity.SetFriendAddRequestRequest](d, calls, "set_friend_add_request")
+	recordAction[entity.SetGroupAddRequestRequest](d, calls, "set_group_add_request")
+	d.Register(entity.HandleQuickOperationAction, QuickOperationHandler(d))
+
+	return d
+}
+
+func quickOperationParams(t *testing.T, event entity.Event, op entity.QuickOperation) map[string]any {
+	t.Helper()
+
+	req, err := entity.NewHandleQuickOperationRequest(event, op)
+	require.NoError(t, err)
+
+	return map[string]any{"context": req.Context, "operation": req.Operation}
+}
+
+//nolint:funlen // 表驱动测试用例较多
+func TestQuickOperationHandler(t *testing.T) {
+	t.Parallel()
+
+	groupMessage := &entity.GroupMessageEvent{
+		PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypeGroup,
+		SubType: "normal", MessageId: 100, GroupId: 200, UserId: 300,
+	}
+	var anonymous *entity.GroupAnonymousUser
+	require.NoError(t, json.Unmarshal([]byte(`{"id":1,"name":"a","flag":"f"}`), &anonymous))
+
+	anonymousMessage := &entity.GroupMessageEvent{
+		PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypeGroup,
+		SubType: "anonymous", MessageId: 100, GroupId: 200, UserId: 80000000, Anonymous: anonymous,
+	}
+	text := func(s string) *entity.MessageValue {
+		return &entity.MessageValue{Type: entity.MessageValueTypeString, StringValue: s}
+	}
+
+	cases := []struct {
+		name  string
+		event entity.Event
+		op    entity.QuickOperation
+		want  []recordedCall
+	}{
+		{
+			name: "private_reply",
+			event: &entity.PrivateMessageEvent{
+				PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypePrivate, UserId: 1,
+			},
+			op: (&entity.MessageQuickOperation{}).SetReply(text("hi")).SetAutoEscape(true),
+			want: []recordedCall{{action: "send_msg", req: &entity.SendMsgRequest{
+				MessageType: entity.MessageTypePrivate, UserId: 1, Message: text("hi"), AutoEscape: true,
+			}}},
+		},
+		{
+			name:  "group_reply_at_sender",
+			event: groupMessage,
+			op:    (&entity.MessageQuickOperation{}).SetReply(text("hi")),
+			want: []recordedCall{{action: "send_msg", req: &entity.SendMsgRequest{
+				MessageType: entity.MessageTypeGroup, GroupId: 200, Message: text("[CQ:at,qq=300] hi"),
+			}}},
+		},
+		{
+			name:  "group_reply_at_sender_array",
+			event: groupMessage,
+			op:    (&entity.MessageQuickOperation{}).SetReply(text("[x]")).SetAutoEscape(true),
+			want: []recordedCall{{action: "send_msg", req: &entity.SendMsgRequest{
+				MessageType: entity.MessageTypeGroup, GroupId: 200,
+				Message: entity.NewMessage().At(300).Text(" ").Text("[x]").Build(),
+			}}},
+		},
+		{
+			name:  "group_delete_kick_ban",
+			event: groupMessage,
+			op: (&entity.MessageQuickOperation{}).SetReply(text("bye")).SetAtSender(false).
+				SetDelete(true).SetKick(true).SetBan(true),
+			want: []recordedCall{
+				{action: "send_msg", req: &entity.SendMsgRequest{
+					MessageType: entity.MessageTypeGroup, GroupId: 200, Message: text("bye"),
+				}},
+				{action: "delete_msg", req: &entity.DeleteMsgRequest{MessageId: 100}},
+				{action: "set_group_kick", req: &entity.SetGroupKickRequest{GroupId: 200, UserId: 300}},
+				{action: "set_group_ban", req: &entity.SetGroupBanRequest{GroupId: 200, UserId: 300, Duration: 1800}},
+			},
+		},
+		{
+			name:  "anonymous_ban",
+			event: anonymousMessage,
+			op:    (&entity.MessageQuickOperation{}).SetReply(text("no")).SetKick(true).SetBan(true).SetBanDuration(60),
+			want: []recordedCall{
+				{action: "send_msg", req: &entity.SendMsgRequest{
+					MessageType: entity.MessageTypeGroup, GroupId: 200, Message: text("no"),
+				}},
+				{action: "set_group_anonymous_ban", req: &entity.SetGroupAnonymousBanRequest{
+					GroupId: 200, Anonymous: anonymous, AnonymousFlag: "f", Duration: 60,
+				}},
+			},
+		},
+		{
+			name: "friend_request",
+			event: &entity.FriendRequestEvent{
+				PostType: entity.EventPostTypeRequest, RequestType: entity.EventRequestTypeFriend, Flag: "f1",
+			},
+			op: (&entity.FriendRequestQuickOperation{}).SetApprove(true).SetRemark("r"),
+			want: []recordedCall{{action: "set_friend_add_request", req: &entity.SetFriendAddRequestRequest{
+				Flag: "f1", Approve: true, Remark: "r",
+			}}},
+		},
+		{
+			name: "group_request",
+			event: &entity.GroupRequestEvent{
+				PostType: entity.EventPostTypeRequest, RequestType: entity.EventRequestTypeGroup,
+				SubType: entity.EventGroupRequestSubTypeInvite, Flag: "f2",
+			},
+			op: (&entity.GroupRequestQuickOperation{}).SetApprove(false).SetReason("no"),
+			want: []recordedCall{{action: "set_group_add_request", req: &entity.SetGroupAddRequestRequest{
+				Flag: "f2", SubType: entity.SetGroupAddRequestSubTypeInvite, Approve: false, Reason: "no",
+			}}},
+		},
+		{
+			name: "notice_without_quick_operation",
+			event: &entity.FriendAddEvent{
+				PostType: entity.EventPostTypeNotice, NoticeType: entity.EventNoticeTypeFriendAdd,
+			},
+			op: entity.QuickOperationMap{"reply": "x"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []recordedCall
+
+			d := newQuickOperationDispatcher(&calls)
+
+			resp, err := d.HandleActionRequest(context.Background(), &entity.ActionRequest{
+				Action: entity.HandleQuickOperationAction,
+				Params: quickOperationParams(t, tc.event, tc.op),
+			})
+			require.NoError(t, err)
+			require.Equal(t, entity.StatusOK, resp.Status)
+			require.Equal(t, tc.want, calls)
+		})
+	}
+}
+
+func TestQuickOperationHandlerErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls []recordedCall
+
+	d := newQuickOperationDispatcher(&calls)
+
+	for _, params := range []map[string]any{
+		nil,
+		{"context": "x"},
+		{"context": map[string]any{"time": 1}},
+		{
+			"context":   map[string]any{"post_type": "message", "message_type": "private"},
+			"operation": map[string]any{"reply": []any{1}},
+		},
+	} {
+		_, err := d.HandleActionRequest(context.Background(), &entity.ActionRequest{
+			Action: entity.HandleQuickOperationAction,
+			Params: params,
+		})
+		require.ErrorIs(t, err, ErrInvalidQuickOperation)
+	}
+
+	require.Empty(t, calls)
+
+	// 具体动作失败时返回其响应
+	d.Register("send_msg", func(_ context.Context, _ map[string]any) (*entity.ActionRawResponse, error) {
+		return &entity.ActionRawResponse{Status: entity.StatusFailed, Retcode: 100, Message: "fail"}, nil
+	})
+
+	resp, err := d.HandleActionRequest(context.Background(), &entity.ActionRequest{
+		Action: entity.HandleQuickOperationAction,
+		Params: quickOperationParams(t, &entity.PrivateMessageEvent{
+			PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypePrivate, UserId: 1,
+		}, (&entity.MessageQuickOperation{}).SetReply(entity.NewMessage().Text("hi").Build())),
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFailed, resp.Status)
+	require.Equal(t, entity.ActionResponseRetcode(100), resp.Retcode)
+}
@@ -1,12 +1,6 @@
 //go:generate go run ../cmd/entity-gen
 package entity
 
-import (
-	"fmt"
-
-	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
-)
-
 // SendPrivateMsgRequest send_private_msg API 的请求参数
 // 发送私聊消息.
 type SendPrivateMsgRequest struct {
@@ -23,6 +17,8 @@ type SendPrivateMsgRequest struct {
 type SendPrivateMsgResponse struct {
 	// 消息 ID
 	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // SendGroupMsgRequest send_group_msg API 的请求参数
@@ -41,6 +37,8 @@ type SendGroupMsgRequest struct {
 type SendGroupMsgResponse struct {
 	// 消息 ID
 	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // SendMsgRequest send_msg API 的请求参数
@@ -63,6 +61,8 @@ type SendMsgRequest struct {
 type SendMsgResponse struct {
 	// 消息 ID
 	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // DeleteMsgRequest delete_msg API 的请求参数
@@ -73,7 +73,10 @@ type DeleteMsgRequest struct {
 }
 
 // DeleteMsgResponse delete_msg API 的响应数据.
-type DeleteMsgResponse struct{}
+type DeleteMsgResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 type BaseUser struct {
 	// QQ 号
@@ -86,6 +89,8 @@ type BaseUser struct {
 	Age int64 `json:"age"`
 	// 备注名
 	Remark string `json:"remark"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetMsgRequest get_msg API 的请求参数
@@ -110,6 +115,8 @@ type GetMsgResponse struct {
 	// 消息内容
 	// 可以是字符串 (CQ 码格式) 或消息段数组
 	Message *MessageValue `json:"message"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetForwardMsgRequest get_forward_msg API 的请求参数
@@ -124,6 +131,8 @@ type GetForwardMsgResponse struct {
 	// 消息内容，使用 [消息的数组格式](../message/array.md) 表示，数组中的消息段全部为 [`node` 消息段](../message/segment.md#合并转发自定义节点)
 	// 可以是字符串 (CQ 码格式) 或消息段数组
 	Message *MessageValue `json:"message"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // SendLikeRequest send_like API 的请求参数
@@ -136,7 +145,10 @@ type SendLikeRequest struct {
 }
 
 // SendLikeResponse send_like API 的响应数据.
-type SendLikeResponse struct{}
+type SendLikeResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupKickRequest set_group_kick API 的请求参数
 // 群组踢人.
@@ -150,7 +162,10 @@ type SetGroupKickRequest struct {
 }
 
 // SetGroupKickResponse set_group_kick API 的响应数据.
-type SetGroupKickResponse struct{}
+type SetGroupKickResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupBanRequest set_group_ban API 的请求参数
 // 群组单人禁言.
@@ -164,7 +179,10 @@ type SetGroupBanRequest struct {
 }
 
 // SetGroupBanResponse set_group_ban API 的响应数据.
-type SetGroupBanResponse struct{}
+type SetGroupBanResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupAnonymousBanRequest set_group_anonymous_ban API 的请求参数
 // 群组匿名用户禁言.
@@ -180,7 +198,10 @@ type SetGroupAnonymousBanRequest struct {
 }
 
 // SetGroupAnonymousBanResponse set_group_anonymous_ban API 的响应数据.
-type SetGroupAnonymousBanResponse struct{}
+type SetGroupAnonymousBanResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupWholeBanRequest set_group_whole_ban API 的请求参数
 // 群组全员禁言.
@@ -192,7 +213,10 @@ type SetGroupWholeBanRequest struct {
 }
 
 // SetGroupWholeBanResponse set_group_whole_ban API 的响应数据.
-type SetGroupWholeBanResponse struct{}
+type SetGroupWholeBanResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupAdminRequest set_group_admin API 的请求参数
 // 群组设置管理员.
@@ -206,7 +230,10 @@ type SetGroupAdminRequest struct {
 }
 
 // SetGroupAdminResponse set_group_admin API 的响应数据.
-type SetGroupAdminResponse struct{}
+type SetGroupAdminResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupAnonymousRequest set_group_anonymous API 的请求参数
 // 群组匿名.
@@ -218,7 +245,10 @@ type SetGroupAnonymousRequest struct {
 }
 
 // SetGroupAnonymousResponse set_group_anonymous API 的响应数据.
-type SetGroupAnonymousResponse struct{}
+type SetGroupAnonymousResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupCardRequest set_group_card API 的请求参数
 // 设置群名片（群备注）.
@@ -232,7 +262,10 @@ type SetGroupCardRequest struct {
 }
 
 // SetGroupCardResponse set_group_card API 的响应数据.
-type SetGroupCardResponse struct{}
+type SetGroupCardResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupNameRequest set_group_name API 的请求参数
 // 设置群名.
@@ -244,7 +277,10 @@ type SetGroupNameRequest struct {
 }
 
 // SetGroupNameResponse set_group_name API 的响应数据.
-type SetGroupNameResponse struct{}
+type SetGroupNameResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupLeaveRequest set_group_leave API 的请求参数
 // 退出群组.
@@ -256,7 +292,10 @@ type SetGroupLeaveRequest struct {
 }
 
 // SetGroupLeaveResponse set_group_leave API 的响应数据.
-type SetGroupLeaveResponse struct{}
+type SetGroupLeaveResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupSpecialTitleRequest set_group_special_title API 的请求参数
 // 设置群组专属头衔.
@@ -272,7 +311,10 @@ type SetGroupSpecialTitleRequest struct {
 }
 
 // SetGroupSpecialTitleResponse set_group_special_title API 的响应数据.
-type SetGroupSpecialTitleResponse struct{}
+type SetGroupSpecialTitleResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetFriendAddRequestRequest set_friend_add_request API 的请求参数
 // 处理加好友请求.
@@ -286,7 +328,10 @@ type SetFriendAddRequestRequest struct {
 }
 
 // SetFriendAddRequestResponse set_friend_add_request API 的响应数据.
-type SetFriendAddRequestResponse struct{}
+type SetFriendAddRequestResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // SetGroupAddRequestRequest set_group_add_request API 的请求参数
 // 处理加群请求／邀请.
@@ -302,7 +347,10 @@ type SetGroupAddRequestRequest struct {
 }
 
 // SetGroupAddRequestResponse set_group_add_request API 的响应数据.
-type SetGroupAddRequestResponse struct{}
+type SetGroupAddRequestResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // GetLoginInfoRequest get_login_info API 的请求参数
 // 获取登录号信息.
@@ -314,6 +362,8 @@ type GetLoginInfoResponse struct {
 	UserId int64 `json:"user_id"`
 	// QQ 昵称
 	Nickname string `json:"nickname"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetStrangerInfoRequest get_stranger_info API 的请求参数
@@ -335,6 +385,8 @@ type GetStrangerInfoResponse struct {
 	Sex SexType `json:"sex"`
 	// 年龄
 	Age int64 `json:"age"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetFriendListRequest get_friend_list API 的请求参数
@@ -351,6 +403,8 @@ type GetFriendListResponseItem struct {
 	Nickname string `json:"nickname"`
 	// 备注名
 	Remark string `json:"remark"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetGroupInfoRequest get_group_info API 的请求参数
@@ -372,6 +426,8 @@ type GetGroupInfoResponse struct {
 	MemberCount int64 `json:"member_count"`
 	// 最大成员数（群容量）
 	MaxMemberCount int64 `json:"max_member_count"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetGroupListRequest get_group_list API 的请求参数
@@ -424,6 +480,8 @@ type GetGroupMemberInfoResponse struct {
 	TitleExpireTime int64 `json:"title_expire_time"`
 	// 是否允许修改群名片
 	CardChangeable bool `json:"card_changeable"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetGroupMemberListRequest get_group_member_list API 的请求参数
@@ -462,6 +520,8 @@ type GetGroupHonorInfoResponse struct {
 	StrongNewbieList []*GroupHonorInfoListItem `json:"strong_newbie_list"`
 	// 快乐之源，仅 `type` 为 `emotion` 或 `all` 时有数据
 	EmotionList []*GroupHonorInfoListItem `json:"emotion_list"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 type GroupHonorInfoCurrentTalkative struct {
@@ -473,6 +533,8 @@ type GroupHonorInfoCurrentTalkative struct {
 	Avatar string `json:"avatar"`
 	// 持续天数
 	DayCount int32 `json:"day_count"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 type GroupHonorInfoListItem struct {
@@ -484,6 +546,8 @@ type GroupHonorInfoListItem struct {
 	Avatar string `json:"avatar"`
 	// 荣誉描述
 	Description string `json:"description"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetCookiesRequest get_cookies API 的请求参数
@@ -497,6 +561,8 @@ type GetCookiesRequest struct {
 type GetCookiesResponse struct {
 	// Cookies
 	Cookies string `json:"cookies"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetCsrfTokenRequest get_csrf_token API 的请求参数
@@ -507,6 +573,8 @@ type GetCsrfTokenRequest struct{}
 type GetCsrfTokenResponse struct {
 	// CSRF Token
 	Token int64 `json:"token"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetCredentialsRequest get_credentials API 的请求参数
@@ -522,6 +590,8 @@ type GetCredentialsResponse struct {
 	Cookies string `json:"cookies"`
 	// CSRF Token
 	CsrfToken int64 `json:"csrf_token"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetRecordRequest get_record API 的请求参数
@@ -537,6 +607,8 @@ type GetRecordRequest struct {
 type GetRecordResponse struct {
 	// 转换后的语音文件路径，如 `/home/somebody/cqhttp/data/record/0B38145AA44505000B38145AA4450500.mp3`
 	File string `json:"file"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetImageRequest get_image API 的请求参数
@@ -550,6 +622,8 @@ type GetImageRequest struct {
 type GetImageResponse struct {
 	// 下载后的图片文件路径，如 `/home/somebody/cqhttp/data/image/6B4DE3DFD1BD271E3297859D41C530F5.jpg`
 	File string `json:"file"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // CanSendImageRequest can_send_image API 的请求参数
@@ -560,6 +634,8 @@ type CanSendImageRequest struct{}
 type CanSendImageResponse struct {
 	// 是或否
 	Yes bool `json:"yes"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // CanSendRecordRequest can_send_record API 的请求参数
@@ -570,6 +646,8 @@ type CanSendRecordRequest struct{}
 type CanSendRecordResponse struct {
 	// 是或否
 	Yes bool `json:"yes"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GetStatusRequest get_status API 的请求参数
@@ -595,40 +673,6 @@ type GetVersionInfoResponse struct {
 	origin map[string]any
 }
 
-// GetOrigin 获取指定 key 的原始字段值.
-func (r *GetVersionInfoResponse) GetOrigin(key string) any {
-	if r == nil || r.origin == nil {
-		return nil
-	}
-
-	return r.origin[key]
-}
-
-// SetOrigin 设置指定 key 的原始字段值.
-func (r *GetVersionInfoResponse) SetOrigin(key string, value any) *GetVersionInfoResponse {
-	if r == nil {
-		return r
-	}
-
-	if r.origin == nil {
-		r.origin = make(map[string]any)
-	}
-
-	r.origin[key] = value
-
-	return r
-}
-
-// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
-func (r *GetVersionInfoResponse) UnmarshalJSON(data []byte) error {
-	err := util.JsonUnmarshalToMapAndStruct(data, r, &r.origin)
-	if err != nil {
-		return fmt.Errorf("failed to unmarshal StatusMeta: %w", err)
-	}
-
-	return nil
-}
-
 // SetRestartRequest set_restart API 的请求参数
 // 重启 OneBot 实现.
 type SetRestartRequest struct {
@@ -637,11 +681,17 @@ type SetRestartRequest struct {
 }
 
 // SetRestartResponse set_restart API 的响应数据.
-type SetRestartResponse struct{}
+type SetRestartResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
 
 // CleanCacheRequest clean_cache API 的请求参数
 // 清理缓存.
 type CleanCacheRequest struct{}
 
 // CleanCacheResponse clean_cache API 的响应数据.
-type CleanCacheResponse struct{}
+type CleanCacheResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
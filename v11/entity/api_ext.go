@@ -0,0 +1,437 @@
+//go:generate go run ../cmd/entity-gen
+package entity
+
+// 本文件定义 go-cqhttp / NapCat 等实现扩展的 API，不属于 OneBot 11 标准.
+
+// SendGroupForwardMsgRequest send_group_forward_msg API 的请求参数
+// 发送群合并转发消息.
+type SendGroupForwardMsgRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+	// 可以是字符串 (CQ 码格式) 或消息段数组
+	Messages *MessageValue `json:"messages"`
+}
+
+// SendGroupForwardMsgResponse send_group_forward_msg API 的响应数据.
+type SendGroupForwardMsgResponse struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 转发消息 ID
+	ForwardId string `json:"forward_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// SendPrivateForwardMsgRequest send_private_forward_msg API 的请求参数
+// 发送私聊合并转发消息.
+type SendPrivateForwardMsgRequest struct {
+	// 对方 QQ 号
+	UserId int64 `json:"user_id"`
+	// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+	// 可以是字符串 (CQ 码格式) 或消息段数组
+	Messages *MessageValue `json:"messages"`
+}
+
+// SendPrivateForwardMsgResponse send_private_forward_msg API 的响应数据.
+type SendPrivateForwardMsgResponse struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 转发消息 ID
+	ForwardId string `json:"forward_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetGroupMsgHistoryRequest get_group_msg_history API 的请求参数
+// 获取群消息历史记录.
+type GetGroupMsgHistoryRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 起始消息序号，可通过 `get_msg` 获得 | 默认值: 0 表示从最新消息开始
+	MessageSeq int64 `json:"message_seq,omitempty"`
+}
+
+// GetGroupMsgHistoryResponse get_group_msg_history API 的响应数据.
+type GetGroupMsgHistoryResponse struct {
+	// 从起始序号开始的前 19 条消息，格式与群消息事件相同
+	Messages []*GroupMessageEvent `json:"messages"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// MarkMsgAsReadRequest mark_msg_as_read API 的请求参数
+// 标记消息已读.
+type MarkMsgAsReadRequest struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+}
+
+// MarkMsgAsReadResponse mark_msg_as_read API 的响应数据.
+type MarkMsgAsReadResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// SetMsgEmojiLikeRequest set_msg_emoji_like API 的请求参数
+// 对消息贴表情回应（NapCat）.
+type SetMsgEmojiLikeRequest struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 表情 ID
+	EmojiId string `json:"emoji_id"`
+	// 是否贴上表情，false 表示取消 | 默认值: true
+	Set *bool `json:"set,omitempty"`
+}
+
+// SetMsgEmojiLikeResponse set_msg_emoji_like API 的响应数据.
+type SetMsgEmojiLikeResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// SetEssenceMsgRequest set_essence_msg API 的请求参数
+// 设置精华消息.
+type SetEssenceMsgRequest struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+}
+
+// SetEssenceMsgResponse set_essence_msg API 的响应数据.
+type SetEssenceMsgResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// DeleteEssenceMsgRequest delete_essence_msg API 的请求参数
+// 移出精华消息.
+type DeleteEssenceMsgRequest struct {
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+}
+
+// DeleteEssenceMsgResponse delete_essence_msg API 的响应数据.
+type DeleteEssenceMsgResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetEssenceMsgListRequest get_essence_msg_list API 的请求参数
+// 获取精华消息列表.
+type GetEssenceMsgListRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+}
+
+// GetEssenceMsgListResponse get_essence_msg_list API 的响应数据.
+type GetEssenceMsgListResponse []*EssenceMsg
+
+// EssenceMsg 精华消息.
+type EssenceMsg struct {
+	// 发送者 QQ 号
+	SenderId int64 `json:"sender_id"`
+	// 发送者昵称
+	SenderNick string `json:"sender_nick"`
+	// 消息发送时间
+	SenderTime int64 `json:"sender_time"`
+	// 操作者 QQ 号
+	OperatorId int64 `json:"operator_id"`
+	// 操作者昵称
+	OperatorNick string `json:"operator_nick"`
+	// 精华设置时间
+	OperatorTime int64 `json:"operator_time"`
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// SendGroupNoticeRequest _send_group_notice API 的请求参数
+// 发送群公告.
+type SendGroupNoticeRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 公告内容
+	Content string `json:"content"`
+	// 图片路径（可选）
+	Image string `json:"image,omitempty"`
+}
+
+// SendGroupNoticeResponse _send_group_notice API 的响应数据.
+type SendGroupNoticeResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetGroupAtAllRemainRequest get_group_at_all_remain API 的请求参数
+// 获取群 @全体成员 剩余次数.
+type GetGroupAtAllRemainRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+}
+
+// GetGroupAtAllRemainResponse get_group_at_all_remain API 的响应数据.
+type GetGroupAtAllRemainResponse struct {
+	// 是否可以 @全体成员
+	CanAtAll bool `json:"can_at_all"`
+	// 群内所有管理当天剩余 @全体成员 次数
+	RemainAtAllCountForGroup int64 `json:"remain_at_all_count_for_group"`
+	// 登录号当天剩余 @全体成员 次数
+	RemainAtAllCountForUin int64 `json:"remain_at_all_count_for_uin"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// UploadGroupFileRequest upload_group_file API 的请求参数
+// 上传群文件.
+type UploadGroupFileRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 本地文件路径
+	File string `json:"file"`
+	// 储存名称
+	Name string `json:"name"`
+	// 父目录 ID，不提供则上传到根目录
+	Folder string `json:"folder,omitempty"`
+}
+
+// UploadGroupFileResponse upload_group_file API 的响应数据.
+type UploadGroupFileResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// UploadPrivateFileRequest upload_private_file API 的请求参数
+// 上传私聊文件.
+type UploadPrivateFileRequest struct {
+	// 对方 QQ 号
+	UserId int64 `json:"user_id"`
+	// 本地文件路径
+	File string `json:"file"`
+	// 文件名称
+	Name string `json:"name"`
+}
+
+// UploadPrivateFileResponse upload_private_file API 的响应数据.
+type UploadPrivateFileResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetGroupFileUrlRequest get_group_file_url API 的请求参数
+// 获取群文件资源链接.
+type GetGroupFileUrlRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件 ID
+	FileId string `json:"file_id"`
+	// 文件类型
+	Busid int64 `json:"busid"`
+}
+
+// GetGroupFileUrlResponse get_group_file_url API 的响应数据.
+type GetGroupFileUrlResponse struct {
+	// 文件下载链接
+	Url string `json:"url"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetGroupRootFilesRequest get_group_root_files API 的请求参数
+// 获取群根目录文件列表.
+type GetGroupRootFilesRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+}
+
+// GetGroupRootFilesResponse get_group_root_files API 的响应数据.
+type GetGroupRootFilesResponse struct {
+	// 文件列表
+	Files []*GroupFile `json:"files"`
+	// 文件夹列表
+	Folders []*GroupFolder `json:"folders"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetGroupFilesByFolderRequest get_group_files_by_folder API 的请求参数
+// 获取群子目录文件列表.
+type GetGroupFilesByFolderRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件夹 ID
+	FolderId string `json:"folder_id"`
+}
+
+// GetGroupFilesByFolderResponse get_group_files_by_folder API 的响应数据.
+type GetGroupFilesByFolderResponse struct {
+	// 文件列表
+	Files []*GroupFile `json:"files"`
+	// 文件夹列表
+	Folders []*GroupFolder `json:"folders"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GroupFile 群文件.
+type GroupFile struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件 ID
+	FileId string `json:"file_id"`
+	// 文件名
+	FileName string `json:"file_name"`
+	// 文件类型
+	Busid int64 `json:"busid"`
+	// 文件大小
+	FileSize int64 `json:"file_size"`
+	// 上传时间
+	UploadTime int64 `json:"upload_time"`
+	// 过期时间，永久文件恒为 0
+	DeadTime int64 `json:"dead_time"`
+	// 最后修改时间
+	ModifyTime int64 `json:"modify_time"`
+	// 下载次数
+	DownloadTimes int64 `json:"download_times"`
+	// 上传者 QQ 号
+	Uploader int64 `json:"uploader"`
+	// 上传者名字
+	UploaderName string `json:"uploader_name"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GroupFolder 群文件夹.
+type GroupFolder struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件夹 ID
+	FolderId string `json:"folder_id"`
+	// 文件夹名
+	FolderName string `json:"folder_name"`
+	// 创建时间
+	CreateTime int64 `json:"create_time"`
+	// 创建者 QQ 号
+	Creator int64 `json:"creator"`
+	// 创建者名字
+	CreatorName string `json:"creator_name"`
+	// 子文件数量
+	TotalFileCount int64 `json:"total_file_count"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// DeleteGroupFileRequest delete_group_file API 的请求参数
+// 删除群文件.
+type DeleteGroupFileRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件 ID
+	FileId string `json:"file_id"`
+	// 文件类型
+	Busid int64 `json:"busid"`
+}
+
+// DeleteGroupFileResponse delete_group_file API 的响应数据.
+type DeleteGroupFileResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// CreateGroupFileFolderRequest create_group_file_folder API 的请求参数
+// 创建群文件夹，仅能在根目录创建.
+type CreateGroupFileFolderRequest struct {
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 文件夹名称
+	Name string `json:"name"`
+	// 父目录 ID，仅支持 `/`
+	ParentId string `json:"parent_id"`
+}
+
+// CreateGroupFileFolderResponse create_group_file_folder API 的响应数据.
+type CreateGroupFileFolderResponse struct {
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// OcrImageRequest ocr_image API 的请求参数
+// 图片 OCR.
+type OcrImageRequest struct {
+	// 图片 ID
+	Image string `json:"image"`
+}
+
+// OcrImageResponse ocr_image API 的响应数据.
+type OcrImageResponse struct {
+	// OCR 结果
+	Texts []*OcrTextDetection `json:"texts"`
+	// 语言
+	Language string `json:"language"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// OcrTextDetection OCR 识别出的文本.
+type OcrTextDetection struct {
+	// 文本
+	Text string `json:"text"`
+	// 置信度
+	Confidence int64 `json:"confidence"`
+	// 坐标
+	Coordinates []*OcrCoordinate `json:"coordinates"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// OcrCoordinate OCR 文本区域的顶点坐标.
+type OcrCoordinate struct {
+	// X 坐标
+	X int64 `json:"x"`
+	// Y 坐标
+	Y int64 `json:"y"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GetOnlineClientsRequest get_online_clients API 的请求参数
+// 获取当前账号在线客户端列表.
+type GetOnlineClientsRequest struct {
+	// 是否无视缓存 | 默认值: false
+	NoCache bool `json:"no_cache,omitempty"`
+}
+
+// GetOnlineClientsResponse get_online_clients API 的响应数据.
+type GetOnlineClientsResponse struct {
+	// 在线客户端列表
+	Clients []*OnlineClient `json:"clients"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// OnlineClient 在线客户端.
+type OnlineClient struct {
+	// 客户端 ID
+	AppId int64 `json:"app_id"`
+	// 设备名称
+	DeviceName string `json:"device_name"`
+	// 设备类型
+	DeviceKind string `json:"device_kind"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// CheckUrlSafelyRequest check_url_safely API 的请求参数
+// 检查链接安全性.
+type CheckUrlSafelyRequest struct {
+	// 需要检查的链接
+	Url string `json:"url"`
+}
+
+// CheckUrlSafelyResponse check_url_safely API 的响应数据.
+type CheckUrlSafelyResponse struct {
+	// 安全等级，1: 安全 2: 未知 3: 危险
+	Level CheckUrlSafelyLevel `json:"level"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
@@ -0,0 +1,9 @@
+package entity
+
+type CheckUrlSafelyLevel int64
+
+const (
+	CheckUrlSafelyLevelSafe      CheckUrlSafelyLevel = 1
+	CheckUrlSafelyLevelUnknown   CheckUrlSafelyLevel = 2
+	CheckUrlSafelyLevelDangerous CheckUrlSafelyLevel = 3
+)
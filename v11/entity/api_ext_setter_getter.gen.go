@@ -0,0 +1,2531 @@
+// Code generated by entity-gen. DO NOT EDIT.
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// GetGroupId
+// 群号
+func (r *SendGroupForwardMsgRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *SendGroupForwardMsgRequest) SetGroupId(v int64) *SendGroupForwardMsgRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetMessages
+// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *SendGroupForwardMsgRequest) GetMessages() *MessageValue {
+	if r == nil {
+		var zero *MessageValue
+		return zero
+	}
+	return r.Messages
+}
+
+// SetMessages
+// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *SendGroupForwardMsgRequest) SetMessages(v *MessageValue) *SendGroupForwardMsgRequest {
+	r.Messages = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *SendGroupForwardMsgResponse) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *SendGroupForwardMsgResponse) SetMessageId(v int64) *SendGroupForwardMsgResponse {
+	r.MessageId = v
+	return r
+}
+
+// GetForwardId
+// 转发消息 ID
+func (r *SendGroupForwardMsgResponse) GetForwardId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.ForwardId
+}
+
+// SetForwardId
+// 转发消息 ID
+func (r *SendGroupForwardMsgResponse) SetForwardId(v string) *SendGroupForwardMsgResponse {
+	r.ForwardId = v
+	return r
+}
+
+// GetUserId
+// 对方 QQ 号
+func (r *SendPrivateForwardMsgRequest) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 对方 QQ 号
+func (r *SendPrivateForwardMsgRequest) SetUserId(v int64) *SendPrivateForwardMsgRequest {
+	r.UserId = v
+	return r
+}
+
+// GetMessages
+// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *SendPrivateForwardMsgRequest) GetMessages() *MessageValue {
+	if r == nil {
+		var zero *MessageValue
+		return zero
+	}
+	return r.Messages
+}
+
+// SetMessages
+// 自定义转发消息，数组中的消息段全部为 `node` 消息段
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *SendPrivateForwardMsgRequest) SetMessages(v *MessageValue) *SendPrivateForwardMsgRequest {
+	r.Messages = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *SendPrivateForwardMsgResponse) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *SendPrivateForwardMsgResponse) SetMessageId(v int64) *SendPrivateForwardMsgResponse {
+	r.MessageId = v
+	return r
+}
+
+// GetForwardId
+// 转发消息 ID
+func (r *SendPrivateForwardMsgResponse) GetForwardId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.ForwardId
+}
+
+// SetForwardId
+// 转发消息 ID
+func (r *SendPrivateForwardMsgResponse) SetForwardId(v string) *SendPrivateForwardMsgResponse {
+	r.ForwardId = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetGroupMsgHistoryRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetGroupMsgHistoryRequest) SetGroupId(v int64) *GetGroupMsgHistoryRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetMessageSeq
+// 起始消息序号，可通过 `get_msg` 获得 | 默认值: 0 表示从最新消息开始
+func (r *GetGroupMsgHistoryRequest) GetMessageSeq() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageSeq
+}
+
+// SetMessageSeq
+// 起始消息序号，可通过 `get_msg` 获得 | 默认值: 0 表示从最新消息开始
+func (r *GetGroupMsgHistoryRequest) SetMessageSeq(v int64) *GetGroupMsgHistoryRequest {
+	r.MessageSeq = v
+	return r
+}
+
+// GetMessages
+// 从起始序号开始的前 19 条消息，格式与群消息事件相同
+func (r *GetGroupMsgHistoryResponse) GetMessages() []*GroupMessageEvent {
+	if r == nil {
+		var zero []*GroupMessageEvent
+		return zero
+	}
+	return r.Messages
+}
+
+// SetMessages
+// 从起始序号开始的前 19 条消息，格式与群消息事件相同
+func (r *GetGroupMsgHistoryResponse) SetMessages(v []*GroupMessageEvent) *GetGroupMsgHistoryResponse {
+	r.Messages = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *MarkMsgAsReadRequest) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *MarkMsgAsReadRequest) SetMessageId(v int64) *MarkMsgAsReadRequest {
+	r.MessageId = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *SetMsgEmojiLikeRequest) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *SetMsgEmojiLikeRequest) SetMessageId(v int64) *SetMsgEmojiLikeRequest {
+	r.MessageId = v
+	return r
+}
+
+// GetEmojiId
+// 表情 ID
+func (r *SetMsgEmojiLikeRequest) GetEmojiId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.EmojiId
+}
+
+// SetEmojiId
+// 表情 ID
+func (r *SetMsgEmojiLikeRequest) SetEmojiId(v string) *SetMsgEmojiLikeRequest {
+	r.EmojiId = v
+	return r
+}
+
+// GetSet
+// 是否贴上表情，false 表示取消 | 默认值: true
+func (r *SetMsgEmojiLikeRequest) GetSet() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Set == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Set
+}
+
+// SetSet
+// 是否贴上表情，false 表示取消 | 默认值: true
+func (r *SetMsgEmojiLikeRequest) SetSet(v bool) *SetMsgEmojiLikeRequest {
+	val := v
+	r.Set = &val
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *SetEssenceMsgRequest) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *SetEssenceMsgRequest) SetMessageId(v int64) *SetEssenceMsgRequest {
+	r.MessageId = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *DeleteEssenceMsgRequest) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *DeleteEssenceMsgRequest) SetMessageId(v int64) *DeleteEssenceMsgRequest {
+	r.MessageId = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetEssenceMsgListRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetEssenceMsgListRequest) SetGroupId(v int64) *GetEssenceMsgListRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetSenderId
+// 发送者 QQ 号
+func (r *EssenceMsg) GetSenderId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SenderId
+}
+
+// SetSenderId
+// 发送者 QQ 号
+func (r *EssenceMsg) SetSenderId(v int64) *EssenceMsg {
+	r.SenderId = v
+	return r
+}
+
+// GetSenderNick
+// 发送者昵称
+func (r *EssenceMsg) GetSenderNick() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.SenderNick
+}
+
+// SetSenderNick
+// 发送者昵称
+func (r *EssenceMsg) SetSenderNick(v string) *EssenceMsg {
+	r.SenderNick = v
+	return r
+}
+
+// GetSenderTime
+// 消息发送时间
+func (r *EssenceMsg) GetSenderTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SenderTime
+}
+
+// SetSenderTime
+// 消息发送时间
+func (r *EssenceMsg) SetSenderTime(v int64) *EssenceMsg {
+	r.SenderTime = v
+	return r
+}
+
+// GetOperatorId
+// 操作者 QQ 号
+func (r *EssenceMsg) GetOperatorId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.OperatorId
+}
+
+// SetOperatorId
+// 操作者 QQ 号
+func (r *EssenceMsg) SetOperatorId(v int64) *EssenceMsg {
+	r.OperatorId = v
+	return r
+}
+
+// GetOperatorNick
+// 操作者昵称
+func (r *EssenceMsg) GetOperatorNick() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.OperatorNick
+}
+
+// SetOperatorNick
+// 操作者昵称
+func (r *EssenceMsg) SetOperatorNick(v string) *EssenceMsg {
+	r.OperatorNick = v
+	return r
+}
+
+// GetOperatorTime
+// 精华设置时间
+func (r *EssenceMsg) GetOperatorTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.OperatorTime
+}
+
+// SetOperatorTime
+// 精华设置时间
+func (r *EssenceMsg) SetOperatorTime(v int64) *EssenceMsg {
+	r.OperatorTime = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *EssenceMsg) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *EssenceMsg) SetMessageId(v int64) *EssenceMsg {
+	r.MessageId = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *SendGroupNoticeRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *SendGroupNoticeRequest) SetGroupId(v int64) *SendGroupNoticeRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetContent
+// 公告内容
+func (r *SendGroupNoticeRequest) GetContent() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Content
+}
+
+// SetContent
+// 公告内容
+func (r *SendGroupNoticeRequest) SetContent(v string) *SendGroupNoticeRequest {
+	r.Content = v
+	return r
+}
+
+// GetImage
+// 图片路径（可选）
+func (r *SendGroupNoticeRequest) GetImage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Image
+}
+
+// SetImage
+// 图片路径（可选）
+func (r *SendGroupNoticeRequest) SetImage(v string) *SendGroupNoticeRequest {
+	r.Image = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetGroupAtAllRemainRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetGroupAtAllRemainRequest) SetGroupId(v int64) *GetGroupAtAllRemainRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetCanAtAll
+// 是否可以 @全体成员
+func (r *GetGroupAtAllRemainResponse) GetCanAtAll() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	return r.CanAtAll
+}
+
+// SetCanAtAll
+// 是否可以 @全体成员
+func (r *GetGroupAtAllRemainResponse) SetCanAtAll(v bool) *GetGroupAtAllRemainResponse {
+	r.CanAtAll = v
+	return r
+}
+
+// GetRemainAtAllCountForGroup
+// 群内所有管理当天剩余 @全体成员 次数
+func (r *GetGroupAtAllRemainResponse) GetRemainAtAllCountForGroup() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.RemainAtAllCountForGroup
+}
+
+// SetRemainAtAllCountForGroup
+// 群内所有管理当天剩余 @全体成员 次数
+func (r *GetGroupAtAllRemainResponse) SetRemainAtAllCountForGroup(v int64) *GetGroupAtAllRemainResponse {
+	r.RemainAtAllCountForGroup = v
+	return r
+}
+
+// GetRemainAtAllCountForUin
+// 登录号当天剩余 @全体成员 次数
+func (r *GetGroupAtAllRemainResponse) GetRemainAtAllCountForUin() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.RemainAtAllCountForUin
+}
+
+// SetRemainAtAllCountForUin
+// 登录号当天剩余 @全体成员 次数
+func (r *GetGroupAtAllRemainResponse) SetRemainAtAllCountForUin(v int64) *GetGroupAtAllRemainResponse {
+	r.RemainAtAllCountForUin = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *UploadGroupFileRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *UploadGroupFileRequest) SetGroupId(v int64) *UploadGroupFileRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetFile
+// 本地文件路径
+func (r *UploadGroupFileRequest) GetFile() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.File
+}
+
+// SetFile
+// 本地文件路径
+func (r *UploadGroupFileRequest) SetFile(v string) *UploadGroupFileRequest {
+	r.File = v
+	return r
+}
+
+// GetName
+// 储存名称
+func (r *UploadGroupFileRequest) GetName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Name
+}
+
+// SetName
+// 储存名称
+func (r *UploadGroupFileRequest) SetName(v string) *UploadGroupFileRequest {
+	r.Name = v
+	return r
+}
+
+// GetFolder
+// 父目录 ID，不提供则上传到根目录
+func (r *UploadGroupFileRequest) GetFolder() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Folder
+}
+
+// SetFolder
+// 父目录 ID，不提供则上传到根目录
+func (r *UploadGroupFileRequest) SetFolder(v string) *UploadGroupFileRequest {
+	r.Folder = v
+	return r
+}
+
+// GetUserId
+// 对方 QQ 号
+func (r *UploadPrivateFileRequest) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 对方 QQ 号
+func (r *UploadPrivateFileRequest) SetUserId(v int64) *UploadPrivateFileRequest {
+	r.UserId = v
+	return r
+}
+
+// GetFile
+// 本地文件路径
+func (r *UploadPrivateFileRequest) GetFile() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.File
+}
+
+// SetFile
+// 本地文件路径
+func (r *UploadPrivateFileRequest) SetFile(v string) *UploadPrivateFileRequest {
+	r.File = v
+	return r
+}
+
+// GetName
+// 文件名称
+func (r *UploadPrivateFileRequest) GetName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Name
+}
+
+// SetName
+// 文件名称
+func (r *UploadPrivateFileRequest) SetName(v string) *UploadPrivateFileRequest {
+	r.Name = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetGroupFileUrlRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetGroupFileUrlRequest) SetGroupId(v int64) *GetGroupFileUrlRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetFileId
+// 文件 ID
+func (r *GetGroupFileUrlRequest) GetFileId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FileId
+}
+
+// SetFileId
+// 文件 ID
+func (r *GetGroupFileUrlRequest) SetFileId(v string) *GetGroupFileUrlRequest {
+	r.FileId = v
+	return r
+}
+
+// GetBusid
+// 文件类型
+func (r *GetGroupFileUrlRequest) GetBusid() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Busid
+}
+
+// SetBusid
+// 文件类型
+func (r *GetGroupFileUrlRequest) SetBusid(v int64) *GetGroupFileUrlRequest {
+	r.Busid = v
+	return r
+}
+
+// GetUrl
+// 文件下载链接
+func (r *GetGroupFileUrlResponse) GetUrl() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Url
+}
+
+// SetUrl
+// 文件下载链接
+func (r *GetGroupFileUrlResponse) SetUrl(v string) *GetGroupFileUrlResponse {
+	r.Url = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetGroupRootFilesRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetGroupRootFilesRequest) SetGroupId(v int64) *GetGroupRootFilesRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetFiles
+// 文件列表
+func (r *GetGroupRootFilesResponse) GetFiles() []*GroupFile {
+	if r == nil {
+		var zero []*GroupFile
+		return zero
+	}
+	return r.Files
+}
+
+// SetFiles
+// 文件列表
+func (r *GetGroupRootFilesResponse) SetFiles(v []*GroupFile) *GetGroupRootFilesResponse {
+	r.Files = v
+	return r
+}
+
+// GetFolders
+// 文件夹列表
+func (r *GetGroupRootFilesResponse) GetFolders() []*GroupFolder {
+	if r == nil {
+		var zero []*GroupFolder
+		return zero
+	}
+	return r.Folders
+}
+
+// SetFolders
+// 文件夹列表
+func (r *GetGroupRootFilesResponse) SetFolders(v []*GroupFolder) *GetGroupRootFilesResponse {
+	r.Folders = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GetGroupFilesByFolderRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GetGroupFilesByFolderRequest) SetGroupId(v int64) *GetGroupFilesByFolderRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetFolderId
+// 文件夹 ID
+func (r *GetGroupFilesByFolderRequest) GetFolderId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FolderId
+}
+
+// SetFolderId
+// 文件夹 ID
+func (r *GetGroupFilesByFolderRequest) SetFolderId(v string) *GetGroupFilesByFolderRequest {
+	r.FolderId = v
+	return r
+}
+
+// GetFiles
+// 文件列表
+func (r *GetGroupFilesByFolderResponse) GetFiles() []*GroupFile {
+	if r == nil {
+		var zero []*GroupFile
+		return zero
+	}
+	return r.Files
+}
+
+// SetFiles
+// 文件列表
+func (r *GetGroupFilesByFolderResponse) SetFiles(v []*GroupFile) *GetGroupFilesByFolderResponse {
+	r.Files = v
+	return r
+}
+
+// GetFolders
+// 文件夹列表
+func (r *GetGroupFilesByFolderResponse) GetFolders() []*GroupFolder {
+	if r == nil {
+		var zero []*GroupFolder
+		return zero
+	}
+	return r.Folders
+}
+
+// SetFolders
+// 文件夹列表
+func (r *GetGroupFilesByFolderResponse) SetFolders(v []*GroupFolder) *GetGroupFilesByFolderResponse {
+	r.Folders = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GroupFile) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GroupFile) SetGroupId(v int64) *GroupFile {
+	r.GroupId = v
+	return r
+}
+
+// GetFileId
+// 文件 ID
+func (r *GroupFile) GetFileId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FileId
+}
+
+// SetFileId
+// 文件 ID
+func (r *GroupFile) SetFileId(v string) *GroupFile {
+	r.FileId = v
+	return r
+}
+
+// GetFileName
+// 文件名
+func (r *GroupFile) GetFileName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FileName
+}
+
+// SetFileName
+// 文件名
+func (r *GroupFile) SetFileName(v string) *GroupFile {
+	r.FileName = v
+	return r
+}
+
+// GetBusid
+// 文件类型
+func (r *GroupFile) GetBusid() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Busid
+}
+
+// SetBusid
+// 文件类型
+func (r *GroupFile) SetBusid(v int64) *GroupFile {
+	r.Busid = v
+	return r
+}
+
+// GetFileSize
+// 文件大小
+func (r *GroupFile) GetFileSize() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.FileSize
+}
+
+// SetFileSize
+// 文件大小
+func (r *GroupFile) SetFileSize(v int64) *GroupFile {
+	r.FileSize = v
+	return r
+}
+
+// GetUploadTime
+// 上传时间
+func (r *GroupFile) GetUploadTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UploadTime
+}
+
+// SetUploadTime
+// 上传时间
+func (r *GroupFile) SetUploadTime(v int64) *GroupFile {
+	r.UploadTime = v
+	return r
+}
+
+// GetDeadTime
+// 过期时间，永久文件恒为 0
+func (r *GroupFile) GetDeadTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.DeadTime
+}
+
+// SetDeadTime
+// 过期时间，永久文件恒为 0
+func (r *GroupFile) SetDeadTime(v int64) *GroupFile {
+	r.DeadTime = v
+	return r
+}
+
+// GetModifyTime
+// 最后修改时间
+func (r *GroupFile) GetModifyTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.ModifyTime
+}
+
+// SetModifyTime
+// 最后修改时间
+func (r *GroupFile) SetModifyTime(v int64) *GroupFile {
+	r.ModifyTime = v
+	return r
+}
+
+// GetDownloadTimes
+// 下载次数
+func (r *GroupFile) GetDownloadTimes() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.DownloadTimes
+}
+
+// SetDownloadTimes
+// 下载次数
+func (r *GroupFile) SetDownloadTimes(v int64) *GroupFile {
+	r.DownloadTimes = v
+	return r
+}
+
+// GetUploader
+// 上传者 QQ 号
+func (r *GroupFile) GetUploader() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Uploader
+}
+
+// SetUploader
+// 上传者 QQ 号
+func (r *GroupFile) SetUploader(v int64) *GroupFile {
+	r.Uploader = v
+	return r
+}
+
+// GetUploaderName
+// 上传者名字
+func (r *GroupFile) GetUploaderName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.UploaderName
+}
+
+// SetUploaderName
+// 上传者名字
+func (r *GroupFile) SetUploaderName(v string) *GroupFile {
+	r.UploaderName = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GroupFolder) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GroupFolder) SetGroupId(v int64) *GroupFolder {
+	r.GroupId = v
+	return r
+}
+
+// GetFolderId
+// 文件夹 ID
+func (r *GroupFolder) GetFolderId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FolderId
+}
+
+// SetFolderId
+// 文件夹 ID
+func (r *GroupFolder) SetFolderId(v string) *GroupFolder {
+	r.FolderId = v
+	return r
+}
+
+// GetFolderName
+// 文件夹名
+func (r *GroupFolder) GetFolderName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FolderName
+}
+
+// SetFolderName
+// 文件夹名
+func (r *GroupFolder) SetFolderName(v string) *GroupFolder {
+	r.FolderName = v
+	return r
+}
+
+// GetCreateTime
+// 创建时间
+func (r *GroupFolder) GetCreateTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.CreateTime
+}
+
+// SetCreateTime
+// 创建时间
+func (r *GroupFolder) SetCreateTime(v int64) *GroupFolder {
+	r.CreateTime = v
+	return r
+}
+
+// GetCreator
+// 创建者 QQ 号
+func (r *GroupFolder) GetCreator() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Creator
+}
+
+// SetCreator
+// 创建者 QQ 号
+func (r *GroupFolder) SetCreator(v int64) *GroupFolder {
+	r.Creator = v
+	return r
+}
+
+// GetCreatorName
+// 创建者名字
+func (r *GroupFolder) GetCreatorName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.CreatorName
+}
+
+// SetCreatorName
+// 创建者名字
+func (r *GroupFolder) SetCreatorName(v string) *GroupFolder {
+	r.CreatorName = v
+	return r
+}
+
+// GetTotalFileCount
+// 子文件数量
+func (r *GroupFolder) GetTotalFileCount() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.TotalFileCount
+}
+
+// SetTotalFileCount
+// 子文件数量
+func (r *GroupFolder) SetTotalFileCount(v int64) *GroupFolder {
+	r.TotalFileCount = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *DeleteGroupFileRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *DeleteGroupFileRequest) SetGroupId(v int64) *DeleteGroupFileRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetFileId
+// 文件 ID
+func (r *DeleteGroupFileRequest) GetFileId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.FileId
+}
+
+// SetFileId
+// 文件 ID
+func (r *DeleteGroupFileRequest) SetFileId(v string) *DeleteGroupFileRequest {
+	r.FileId = v
+	return r
+}
+
+// GetBusid
+// 文件类型
+func (r *DeleteGroupFileRequest) GetBusid() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Busid
+}
+
+// SetBusid
+// 文件类型
+func (r *DeleteGroupFileRequest) SetBusid(v int64) *DeleteGroupFileRequest {
+	r.Busid = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *CreateGroupFileFolderRequest) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *CreateGroupFileFolderRequest) SetGroupId(v int64) *CreateGroupFileFolderRequest {
+	r.GroupId = v
+	return r
+}
+
+// GetName
+// 文件夹名称
+func (r *CreateGroupFileFolderRequest) GetName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Name
+}
+
+// SetName
+// 文件夹名称
+func (r *CreateGroupFileFolderRequest) SetName(v string) *CreateGroupFileFolderRequest {
+	r.Name = v
+	return r
+}
+
+// GetParentId
+// 父目录 ID，仅支持 `/`
+func (r *CreateGroupFileFolderRequest) GetParentId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.ParentId
+}
+
+// SetParentId
+// 父目录 ID，仅支持 `/`
+func (r *CreateGroupFileFolderRequest) SetParentId(v string) *CreateGroupFileFolderRequest {
+	r.ParentId = v
+	return r
+}
+
+// GetImage
+// 图片 ID
+func (r *OcrImageRequest) GetImage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Image
+}
+
+// SetImage
+// 图片 ID
+func (r *OcrImageRequest) SetImage(v string) *OcrImageRequest {
+	r.Image = v
+	return r
+}
+
+// GetTexts
+// OCR 结果
+func (r *OcrImageResponse) GetTexts() []*OcrTextDetection {
+	if r == nil {
+		var zero []*OcrTextDetection
+		return zero
+	}
+	return r.Texts
+}
+
+// SetTexts
+// OCR 结果
+func (r *OcrImageResponse) SetTexts(v []*OcrTextDetection) *OcrImageResponse {
+	r.Texts = v
+	return r
+}
+
+// GetLanguage
+// 语言
+func (r *OcrImageResponse) GetLanguage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Language
+}
+
+// SetLanguage
+// 语言
+func (r *OcrImageResponse) SetLanguage(v string) *OcrImageResponse {
+	r.Language = v
+	return r
+}
+
+// GetText
+// 文本
+func (r *OcrTextDetection) GetText() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Text
+}
+
+// SetText
+// 文本
+func (r *OcrTextDetection) SetText(v string) *OcrTextDetection {
+	r.Text = v
+	return r
+}
+
+// GetConfidence
+// 置信度
+func (r *OcrTextDetection) GetConfidence() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Confidence
+}
+
+// SetConfidence
+// 置信度
+func (r *OcrTextDetection) SetConfidence(v int64) *OcrTextDetection {
+	r.Confidence = v
+	return r
+}
+
+// GetCoordinates
+// 坐标
+func (r *OcrTextDetection) GetCoordinates() []*OcrCoordinate {
+	if r == nil {
+		var zero []*OcrCoordinate
+		return zero
+	}
+	return r.Coordinates
+}
+
+// SetCoordinates
+// 坐标
+func (r *OcrTextDetection) SetCoordinates(v []*OcrCoordinate) *OcrTextDetection {
+	r.Coordinates = v
+	return r
+}
+
+// GetX
+// X 坐标
+func (r *OcrCoordinate) GetX() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.X
+}
+
+// SetX
+// X 坐标
+func (r *OcrCoordinate) SetX(v int64) *OcrCoordinate {
+	r.X = v
+	return r
+}
+
+// GetY
+// Y 坐标
+func (r *OcrCoordinate) GetY() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Y
+}
+
+// SetY
+// Y 坐标
+func (r *OcrCoordinate) SetY(v int64) *OcrCoordinate {
+	r.Y = v
+	return r
+}
+
+// GetNoCache
+// 是否无视缓存 | 默认值: false
+func (r *GetOnlineClientsRequest) GetNoCache() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	return r.NoCache
+}
+
+// SetNoCache
+// 是否无视缓存 | 默认值: false
+func (r *GetOnlineClientsRequest) SetNoCache(v bool) *GetOnlineClientsRequest {
+	r.NoCache = v
+	return r
+}
+
+// GetClients
+// 在线客户端列表
+func (r *GetOnlineClientsResponse) GetClients() []*OnlineClient {
+	if r == nil {
+		var zero []*OnlineClient
+		return zero
+	}
+	return r.Clients
+}
+
+// SetClients
+// 在线客户端列表
+func (r *GetOnlineClientsResponse) SetClients(v []*OnlineClient) *GetOnlineClientsResponse {
+	r.Clients = v
+	return r
+}
+
+// GetAppId
+// 客户端 ID
+func (r *OnlineClient) GetAppId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.AppId
+}
+
+// SetAppId
+// 客户端 ID
+func (r *OnlineClient) SetAppId(v int64) *OnlineClient {
+	r.AppId = v
+	return r
+}
+
+// GetDeviceName
+// 设备名称
+func (r *OnlineClient) GetDeviceName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.DeviceName
+}
+
+// SetDeviceName
+// 设备名称
+func (r *OnlineClient) SetDeviceName(v string) *OnlineClient {
+	r.DeviceName = v
+	return r
+}
+
+// GetDeviceKind
+// 设备类型
+func (r *OnlineClient) GetDeviceKind() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.DeviceKind
+}
+
+// SetDeviceKind
+// 设备类型
+func (r *OnlineClient) SetDeviceKind(v string) *OnlineClient {
+	r.DeviceKind = v
+	return r
+}
+
+// GetUrl
+// 需要检查的链接
+func (r *CheckUrlSafelyRequest) GetUrl() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Url
+}
+
+// SetUrl
+// 需要检查的链接
+func (r *CheckUrlSafelyRequest) SetUrl(v string) *CheckUrlSafelyRequest {
+	r.Url = v
+	return r
+}
+
+// GetLevel
+// 安全等级，1: 安全 2: 未知 3: 危险
+func (r *CheckUrlSafelyResponse) GetLevel() CheckUrlSafelyLevel {
+	if r == nil {
+		var zero CheckUrlSafelyLevel
+		return zero
+	}
+	return r.Level
+}
+
+// SetLevel
+// 安全等级，1: 安全 2: 未知 3: 危险
+func (r *CheckUrlSafelyResponse) SetLevel(v CheckUrlSafelyLevel) *CheckUrlSafelyResponse {
+	r.Level = v
+	return r
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendGroupForwardMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendGroupForwardMsgResponse) SetOrigin(key string, value any) *SendGroupForwardMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendGroupForwardMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SendGroupForwardMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendGroupForwardMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendGroupForwardMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SendGroupForwardMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendGroupForwardMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendPrivateForwardMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendPrivateForwardMsgResponse) SetOrigin(key string, value any) *SendPrivateForwardMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendPrivateForwardMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SendPrivateForwardMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendPrivateForwardMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendPrivateForwardMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SendPrivateForwardMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendPrivateForwardMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupMsgHistoryResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupMsgHistoryResponse) SetOrigin(key string, value any) *GetGroupMsgHistoryResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupMsgHistoryResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupMsgHistoryResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupMsgHistoryResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupMsgHistoryResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupMsgHistoryResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupMsgHistoryResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *MarkMsgAsReadResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *MarkMsgAsReadResponse) SetOrigin(key string, value any) *MarkMsgAsReadResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *MarkMsgAsReadResponse) UnmarshalJSON(data []byte) error {
+	type alias MarkMsgAsReadResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal MarkMsgAsReadResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r MarkMsgAsReadResponse) MarshalJSON() ([]byte, error) {
+	type alias MarkMsgAsReadResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MarkMsgAsReadResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetMsgEmojiLikeResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetMsgEmojiLikeResponse) SetOrigin(key string, value any) *SetMsgEmojiLikeResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetMsgEmojiLikeResponse) UnmarshalJSON(data []byte) error {
+	type alias SetMsgEmojiLikeResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetMsgEmojiLikeResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetMsgEmojiLikeResponse) MarshalJSON() ([]byte, error) {
+	type alias SetMsgEmojiLikeResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetMsgEmojiLikeResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetEssenceMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetEssenceMsgResponse) SetOrigin(key string, value any) *SetEssenceMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetEssenceMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SetEssenceMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetEssenceMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetEssenceMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SetEssenceMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetEssenceMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *DeleteEssenceMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *DeleteEssenceMsgResponse) SetOrigin(key string, value any) *DeleteEssenceMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *DeleteEssenceMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias DeleteEssenceMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal DeleteEssenceMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r DeleteEssenceMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias DeleteEssenceMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal DeleteEssenceMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *EssenceMsg) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *EssenceMsg) SetOrigin(key string, value any) *EssenceMsg {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *EssenceMsg) UnmarshalJSON(data []byte) error {
+	type alias EssenceMsg
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal EssenceMsg: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r EssenceMsg) MarshalJSON() ([]byte, error) {
+	type alias EssenceMsg
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal EssenceMsg: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendGroupNoticeResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendGroupNoticeResponse) SetOrigin(key string, value any) *SendGroupNoticeResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendGroupNoticeResponse) UnmarshalJSON(data []byte) error {
+	type alias SendGroupNoticeResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendGroupNoticeResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendGroupNoticeResponse) MarshalJSON() ([]byte, error) {
+	type alias SendGroupNoticeResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendGroupNoticeResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupAtAllRemainResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupAtAllRemainResponse) SetOrigin(key string, value any) *GetGroupAtAllRemainResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupAtAllRemainResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupAtAllRemainResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupAtAllRemainResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupAtAllRemainResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupAtAllRemainResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupAtAllRemainResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *UploadGroupFileResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *UploadGroupFileResponse) SetOrigin(key string, value any) *UploadGroupFileResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *UploadGroupFileResponse) UnmarshalJSON(data []byte) error {
+	type alias UploadGroupFileResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal UploadGroupFileResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r UploadGroupFileResponse) MarshalJSON() ([]byte, error) {
+	type alias UploadGroupFileResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal UploadGroupFileResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *UploadPrivateFileResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *UploadPrivateFileResponse) SetOrigin(key string, value any) *UploadPrivateFileResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *UploadPrivateFileResponse) UnmarshalJSON(data []byte) error {
+	type alias UploadPrivateFileResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal UploadPrivateFileResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r UploadPrivateFileResponse) MarshalJSON() ([]byte, error) {
+	type alias UploadPrivateFileResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal UploadPrivateFileResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupFileUrlResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupFileUrlResponse) SetOrigin(key string, value any) *GetGroupFileUrlResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupFileUrlResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupFileUrlResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupFileUrlResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupFileUrlResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupFileUrlResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupFileUrlResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupRootFilesResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupRootFilesResponse) SetOrigin(key string, value any) *GetGroupRootFilesResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupRootFilesResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupRootFilesResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupRootFilesResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupRootFilesResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupRootFilesResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupRootFilesResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupFilesByFolderResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupFilesByFolderResponse) SetOrigin(key string, value any) *GetGroupFilesByFolderResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupFilesByFolderResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupFilesByFolderResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupFilesByFolderResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupFilesByFolderResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupFilesByFolderResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupFilesByFolderResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupFile) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupFile) SetOrigin(key string, value any) *GroupFile {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupFile) UnmarshalJSON(data []byte) error {
+	type alias GroupFile
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupFile: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupFile) MarshalJSON() ([]byte, error) {
+	type alias GroupFile
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupFile: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupFolder) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupFolder) SetOrigin(key string, value any) *GroupFolder {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupFolder) UnmarshalJSON(data []byte) error {
+	type alias GroupFolder
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupFolder: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupFolder) MarshalJSON() ([]byte, error) {
+	type alias GroupFolder
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupFolder: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *DeleteGroupFileResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *DeleteGroupFileResponse) SetOrigin(key string, value any) *DeleteGroupFileResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *DeleteGroupFileResponse) UnmarshalJSON(data []byte) error {
+	type alias DeleteGroupFileResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal DeleteGroupFileResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r DeleteGroupFileResponse) MarshalJSON() ([]byte, error) {
+	type alias DeleteGroupFileResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal DeleteGroupFileResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *CreateGroupFileFolderResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *CreateGroupFileFolderResponse) SetOrigin(key string, value any) *CreateGroupFileFolderResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *CreateGroupFileFolderResponse) UnmarshalJSON(data []byte) error {
+	type alias CreateGroupFileFolderResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal CreateGroupFileFolderResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r CreateGroupFileFolderResponse) MarshalJSON() ([]byte, error) {
+	type alias CreateGroupFileFolderResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CreateGroupFileFolderResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OcrImageResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OcrImageResponse) SetOrigin(key string, value any) *OcrImageResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OcrImageResponse) UnmarshalJSON(data []byte) error {
+	type alias OcrImageResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OcrImageResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OcrImageResponse) MarshalJSON() ([]byte, error) {
+	type alias OcrImageResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OcrImageResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OcrTextDetection) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OcrTextDetection) SetOrigin(key string, value any) *OcrTextDetection {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OcrTextDetection) UnmarshalJSON(data []byte) error {
+	type alias OcrTextDetection
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OcrTextDetection: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OcrTextDetection) MarshalJSON() ([]byte, error) {
+	type alias OcrTextDetection
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OcrTextDetection: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OcrCoordinate) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OcrCoordinate) SetOrigin(key string, value any) *OcrCoordinate {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OcrCoordinate) UnmarshalJSON(data []byte) error {
+	type alias OcrCoordinate
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OcrCoordinate: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OcrCoordinate) MarshalJSON() ([]byte, error) {
+	type alias OcrCoordinate
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OcrCoordinate: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetOnlineClientsResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetOnlineClientsResponse) SetOrigin(key string, value any) *GetOnlineClientsResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetOnlineClientsResponse) UnmarshalJSON(data []byte) error {
+	type alias GetOnlineClientsResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetOnlineClientsResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetOnlineClientsResponse) MarshalJSON() ([]byte, error) {
+	type alias GetOnlineClientsResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetOnlineClientsResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OnlineClient) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OnlineClient) SetOrigin(key string, value any) *OnlineClient {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OnlineClient) UnmarshalJSON(data []byte) error {
+	type alias OnlineClient
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OnlineClient: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OnlineClient) MarshalJSON() ([]byte, error) {
+	type alias OnlineClient
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OnlineClient: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *CheckUrlSafelyResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *CheckUrlSafelyResponse) SetOrigin(key string, value any) *CheckUrlSafelyResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *CheckUrlSafelyResponse) UnmarshalJSON(data []byte) error {
+	type alias CheckUrlSafelyResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal CheckUrlSafelyResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r CheckUrlSafelyResponse) MarshalJSON() ([]byte, error) {
+	type alias CheckUrlSafelyResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CheckUrlSafelyResponse: %w", err)
+	}
+	return data, nil
+}
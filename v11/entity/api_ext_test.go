@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensionResponsesUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var files GetGroupRootFilesResponse
+
+	err := json.Unmarshal([]byte(`{
+		"files": [{"group_id": 1, "file_id": "/abc", "file_name": "a.txt", "busid": 102, "file_size": 3}],
+		"folders": [{"group_id": 1, "folder_id": "/def", "folder_name": "docs", "total_file_count": 2}]
+	}`), &files)
+	require.NoError(t, err)
+	require.Len(t, files.GetFiles(), 1)
+	require.Equal(t, "/abc", files.GetFiles()[0].GetFileId())
+	require.Equal(t, int64(102), files.GetFiles()[0].GetBusid())
+	require.Equal(t, "docs", files.GetFolders()[0].GetFolderName())
+
+	var ocr OcrImageResponse
+
+	err = json.Unmarshal([]byte(`{
+		"texts": [{"text": "hi", "confidence": 99, "coordinates": [{"x": 1, "y": 2}]}],
+		"language": "zh"
+	}`), &ocr)
+	require.NoError(t, err)
+	require.Equal(t, "hi", ocr.GetTexts()[0].GetText())
+	require.Equal(t, int64(2), ocr.GetTexts()[0].GetCoordinates()[0].GetY())
+
+	var history GetGroupMsgHistoryResponse
+
+	err = json.Unmarshal([]byte(`{"messages": [{
+		"post_type": "message", "message_type": "group", "message_id": 5, "group_id": 1, "message": "hi"
+	}]}`), &history)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), history.GetMessages()[0].GetMessageId())
+	require.Equal(t, "hi", history.GetMessages()[0].GetMessage().PlainText())
+
+	var essence GetEssenceMsgListResponse
+
+	err = json.Unmarshal([]byte(`[{"sender_id": 1, "message_id": 2, "extra": "x"}]`), &essence)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), essence[0].GetMessageId())
+	require.Equal(t, "x", essence[0].GetOrigin("extra"))
+}
+
+func TestExtensionRequestsMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal((&SetMsgEmojiLikeRequest{MessageId: 1, EmojiId: "76"}).SetSet(false))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"message_id":1,"emoji_id":"76","set":false}`, string(data))
+
+	data, err = json.Marshal(&SendGroupForwardMsgRequest{
+		GroupId:  1,
+		Messages: NewMessage().Append(&NodeSegmentData{Id: "10"}).Build(),
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"group_id":1,"messages":[{"type":"node","data":{"id":"10"}}]}`, string(data))
+}
@@ -2,6 +2,12 @@
 
 package entity
 
+import (
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
 // GetUserId
 // 对方 QQ 号
 func (r *SendPrivateMsgRequest) GetUserId() int64 {
@@ -2323,3 +2329,1523 @@ func (r *SetRestartRequest) SetDelay(v int64) *SetRestartRequest {
 	r.Delay = v
 	return r
 }
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendPrivateMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendPrivateMsgResponse) SetOrigin(key string, value any) *SendPrivateMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendPrivateMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SendPrivateMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendPrivateMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendPrivateMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SendPrivateMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendPrivateMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendGroupMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendGroupMsgResponse) SetOrigin(key string, value any) *SendGroupMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendGroupMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SendGroupMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendGroupMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendGroupMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SendGroupMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendGroupMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendMsgResponse) SetOrigin(key string, value any) *SendMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias SendMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias SendMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *DeleteMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *DeleteMsgResponse) SetOrigin(key string, value any) *DeleteMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *DeleteMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias DeleteMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal DeleteMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r DeleteMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias DeleteMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal DeleteMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *BaseUser) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *BaseUser) SetOrigin(key string, value any) *BaseUser {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *BaseUser) UnmarshalJSON(data []byte) error {
+	type alias BaseUser
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal BaseUser: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r BaseUser) MarshalJSON() ([]byte, error) {
+	type alias BaseUser
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal BaseUser: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetMsgResponse) SetOrigin(key string, value any) *GetMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias GetMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias GetMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetForwardMsgResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetForwardMsgResponse) SetOrigin(key string, value any) *GetForwardMsgResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetForwardMsgResponse) UnmarshalJSON(data []byte) error {
+	type alias GetForwardMsgResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetForwardMsgResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetForwardMsgResponse) MarshalJSON() ([]byte, error) {
+	type alias GetForwardMsgResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetForwardMsgResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SendLikeResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SendLikeResponse) SetOrigin(key string, value any) *SendLikeResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SendLikeResponse) UnmarshalJSON(data []byte) error {
+	type alias SendLikeResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SendLikeResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SendLikeResponse) MarshalJSON() ([]byte, error) {
+	type alias SendLikeResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SendLikeResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupKickResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupKickResponse) SetOrigin(key string, value any) *SetGroupKickResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupKickResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupKickResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupKickResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupKickResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupKickResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupKickResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupBanResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupBanResponse) SetOrigin(key string, value any) *SetGroupBanResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupBanResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupBanResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupBanResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupBanResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupBanResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupBanResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupAnonymousBanResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupAnonymousBanResponse) SetOrigin(key string, value any) *SetGroupAnonymousBanResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupAnonymousBanResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupAnonymousBanResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupAnonymousBanResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupAnonymousBanResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupAnonymousBanResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupAnonymousBanResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupWholeBanResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupWholeBanResponse) SetOrigin(key string, value any) *SetGroupWholeBanResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupWholeBanResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupWholeBanResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupWholeBanResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupWholeBanResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupWholeBanResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupWholeBanResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupAdminResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupAdminResponse) SetOrigin(key string, value any) *SetGroupAdminResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupAdminResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupAdminResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupAdminResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupAdminResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupAdminResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupAdminResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupAnonymousResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupAnonymousResponse) SetOrigin(key string, value any) *SetGroupAnonymousResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupAnonymousResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupAnonymousResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupAnonymousResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupAnonymousResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupAnonymousResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupAnonymousResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupCardResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupCardResponse) SetOrigin(key string, value any) *SetGroupCardResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupCardResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupCardResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupCardResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupCardResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupCardResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupCardResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupNameResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupNameResponse) SetOrigin(key string, value any) *SetGroupNameResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupNameResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupNameResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupNameResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupNameResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupNameResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupNameResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupLeaveResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupLeaveResponse) SetOrigin(key string, value any) *SetGroupLeaveResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupLeaveResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupLeaveResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupLeaveResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupLeaveResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupLeaveResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupLeaveResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupSpecialTitleResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupSpecialTitleResponse) SetOrigin(key string, value any) *SetGroupSpecialTitleResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupSpecialTitleResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupSpecialTitleResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupSpecialTitleResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupSpecialTitleResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupSpecialTitleResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupSpecialTitleResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetFriendAddRequestResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetFriendAddRequestResponse) SetOrigin(key string, value any) *SetFriendAddRequestResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetFriendAddRequestResponse) UnmarshalJSON(data []byte) error {
+	type alias SetFriendAddRequestResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetFriendAddRequestResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetFriendAddRequestResponse) MarshalJSON() ([]byte, error) {
+	type alias SetFriendAddRequestResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetFriendAddRequestResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetGroupAddRequestResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetGroupAddRequestResponse) SetOrigin(key string, value any) *SetGroupAddRequestResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetGroupAddRequestResponse) UnmarshalJSON(data []byte) error {
+	type alias SetGroupAddRequestResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetGroupAddRequestResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetGroupAddRequestResponse) MarshalJSON() ([]byte, error) {
+	type alias SetGroupAddRequestResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetGroupAddRequestResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetLoginInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetLoginInfoResponse) SetOrigin(key string, value any) *GetLoginInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetLoginInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetLoginInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetLoginInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetLoginInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetLoginInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetLoginInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetStrangerInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetStrangerInfoResponse) SetOrigin(key string, value any) *GetStrangerInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetStrangerInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetStrangerInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetStrangerInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetStrangerInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetStrangerInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetStrangerInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetFriendListResponseItem) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetFriendListResponseItem) SetOrigin(key string, value any) *GetFriendListResponseItem {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetFriendListResponseItem) UnmarshalJSON(data []byte) error {
+	type alias GetFriendListResponseItem
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetFriendListResponseItem: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetFriendListResponseItem) MarshalJSON() ([]byte, error) {
+	type alias GetFriendListResponseItem
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetFriendListResponseItem: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupInfoResponse) SetOrigin(key string, value any) *GetGroupInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupMemberInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupMemberInfoResponse) SetOrigin(key string, value any) *GetGroupMemberInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupMemberInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupMemberInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupMemberInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupMemberInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupMemberInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupMemberInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetGroupHonorInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetGroupHonorInfoResponse) SetOrigin(key string, value any) *GetGroupHonorInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetGroupHonorInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetGroupHonorInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetGroupHonorInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetGroupHonorInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetGroupHonorInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetGroupHonorInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupHonorInfoCurrentTalkative) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupHonorInfoCurrentTalkative) SetOrigin(key string, value any) *GroupHonorInfoCurrentTalkative {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupHonorInfoCurrentTalkative) UnmarshalJSON(data []byte) error {
+	type alias GroupHonorInfoCurrentTalkative
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupHonorInfoCurrentTalkative: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupHonorInfoCurrentTalkative) MarshalJSON() ([]byte, error) {
+	type alias GroupHonorInfoCurrentTalkative
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupHonorInfoCurrentTalkative: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupHonorInfoListItem) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupHonorInfoListItem) SetOrigin(key string, value any) *GroupHonorInfoListItem {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupHonorInfoListItem) UnmarshalJSON(data []byte) error {
+	type alias GroupHonorInfoListItem
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupHonorInfoListItem: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupHonorInfoListItem) MarshalJSON() ([]byte, error) {
+	type alias GroupHonorInfoListItem
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupHonorInfoListItem: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetCookiesResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetCookiesResponse) SetOrigin(key string, value any) *GetCookiesResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetCookiesResponse) UnmarshalJSON(data []byte) error {
+	type alias GetCookiesResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetCookiesResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetCookiesResponse) MarshalJSON() ([]byte, error) {
+	type alias GetCookiesResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetCookiesResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetCsrfTokenResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetCsrfTokenResponse) SetOrigin(key string, value any) *GetCsrfTokenResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetCsrfTokenResponse) UnmarshalJSON(data []byte) error {
+	type alias GetCsrfTokenResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetCsrfTokenResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetCsrfTokenResponse) MarshalJSON() ([]byte, error) {
+	type alias GetCsrfTokenResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetCsrfTokenResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetCredentialsResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetCredentialsResponse) SetOrigin(key string, value any) *GetCredentialsResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetCredentialsResponse) UnmarshalJSON(data []byte) error {
+	type alias GetCredentialsResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetCredentialsResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetCredentialsResponse) MarshalJSON() ([]byte, error) {
+	type alias GetCredentialsResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetCredentialsResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetRecordResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetRecordResponse) SetOrigin(key string, value any) *GetRecordResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetRecordResponse) UnmarshalJSON(data []byte) error {
+	type alias GetRecordResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetRecordResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetRecordResponse) MarshalJSON() ([]byte, error) {
+	type alias GetRecordResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetRecordResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetImageResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetImageResponse) SetOrigin(key string, value any) *GetImageResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetImageResponse) UnmarshalJSON(data []byte) error {
+	type alias GetImageResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetImageResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetImageResponse) MarshalJSON() ([]byte, error) {
+	type alias GetImageResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetImageResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *CanSendImageResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *CanSendImageResponse) SetOrigin(key string, value any) *CanSendImageResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *CanSendImageResponse) UnmarshalJSON(data []byte) error {
+	type alias CanSendImageResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal CanSendImageResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r CanSendImageResponse) MarshalJSON() ([]byte, error) {
+	type alias CanSendImageResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CanSendImageResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *CanSendRecordResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *CanSendRecordResponse) SetOrigin(key string, value any) *CanSendRecordResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *CanSendRecordResponse) UnmarshalJSON(data []byte) error {
+	type alias CanSendRecordResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal CanSendRecordResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r CanSendRecordResponse) MarshalJSON() ([]byte, error) {
+	type alias CanSendRecordResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CanSendRecordResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GetVersionInfoResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GetVersionInfoResponse) SetOrigin(key string, value any) *GetVersionInfoResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GetVersionInfoResponse) UnmarshalJSON(data []byte) error {
+	type alias GetVersionInfoResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GetVersionInfoResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GetVersionInfoResponse) MarshalJSON() ([]byte, error) {
+	type alias GetVersionInfoResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GetVersionInfoResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *SetRestartResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *SetRestartResponse) SetOrigin(key string, value any) *SetRestartResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *SetRestartResponse) UnmarshalJSON(data []byte) error {
+	type alias SetRestartResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal SetRestartResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r SetRestartResponse) MarshalJSON() ([]byte, error) {
+	type alias SetRestartResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SetRestartResponse: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *CleanCacheResponse) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *CleanCacheResponse) SetOrigin(key string, value any) *CleanCacheResponse {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *CleanCacheResponse) UnmarshalJSON(data []byte) error {
+	type alias CleanCacheResponse
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal CleanCacheResponse: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r CleanCacheResponse) MarshalJSON() ([]byte, error) {
+	type alias CleanCacheResponse
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal CleanCacheResponse: %w", err)
+	}
+	return data, nil
+}
@@ -38,7 +38,7 @@ func TestGetVersionInfoResponseUnmarshalJSON(t *testing.T) {
 	require.Equal(t, "1.2.3", response.GetOrigin("app_version"))
 	require.Equal(t, "v11", response.GetOrigin("protocol_version"))
 	require.Equal(t, "custom_value", response.GetOrigin("custom_field"))
-	require.InEpsilon(t, float64(42), response.GetOrigin("extra_number"), 0)
+	require.Equal(t, json.Number("42"), response.GetOrigin("extra_number"))
 
 	// 测试 SetOrigin
 	response.SetOrigin("new_field", "new_value")
@@ -49,3 +49,50 @@ func TestGetVersionInfoResponseUnmarshalJSON(t *testing.T) {
 	require.Equal(t, "value1", response.GetOrigin("chain1"))
 	require.Equal(t, "value2", response.GetOrigin("chain2"))
 }
+
+func TestResponseOriginRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	jsonData := `[
+		{"group_id": 1, "group_name": "a", "member_count": 2, "max_member_count": 3, "group_create_time": 4},
+		{"group_id": 5, "group_name": "b", "member_count": 6, "max_member_count": 7}
+	]`
+
+	var response GetGroupListResponse
+
+	err := json.Unmarshal([]byte(jsonData), &response)
+	require.NoError(t, err)
+	require.Len(t, response, 2)
+	require.Equal(t, "a", response[0].GroupName)
+	require.Equal(t, json.Number("4"), response[0].GetOrigin("group_create_time"))
+	require.Nil(t, response[1].GetOrigin("group_create_time"))
+
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonData, string(data))
+}
+
+func TestResponseOriginMarshalValue(t *testing.T) {
+	t.Parallel()
+
+	jsonData := `{"app_name": "a", "app_version": "1", "protocol_version": "v11", "coolq_edition": "pro"}`
+
+	var response GetVersionInfoResponse
+
+	err := json.Unmarshal([]byte(jsonData), &response)
+	require.NoError(t, err)
+
+	fromValue, err := json.Marshal(response)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonData, string(fromValue))
+
+	fromPointer, err := json.Marshal(&response)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonData, string(fromPointer))
+
+	wrapped, err := json.Marshal(struct {
+		Data GetVersionInfoResponse `json:"data"`
+	}{Data: response})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data": `+jsonData+`}`, string(wrapped))
+}
@@ -2,6 +2,12 @@
 
 package entity
 
+import (
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
 // GetOnline
 // 当前 QQ 在线，`null` 表示无法查询到在线状态
 func (r *StatusMeta) GetOnline() bool {
@@ -86,3 +92,83 @@ func (r *GroupAnonymousUser) SetFlag(v string) *GroupAnonymousUser {
 	r.Flag = v
 	return r
 }
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *StatusMeta) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *StatusMeta) SetOrigin(key string, value any) *StatusMeta {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *StatusMeta) UnmarshalJSON(data []byte) error {
+	type alias StatusMeta
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal StatusMeta: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r StatusMeta) MarshalJSON() ([]byte, error) {
+	type alias StatusMeta
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal StatusMeta: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupAnonymousUser) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupAnonymousUser) SetOrigin(key string, value any) *GroupAnonymousUser {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupAnonymousUser) UnmarshalJSON(data []byte) error {
+	type alias GroupAnonymousUser
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupAnonymousUser: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupAnonymousUser) MarshalJSON() ([]byte, error) {
+	type alias GroupAnonymousUser
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupAnonymousUser: %w", err)
+	}
+	return data, nil
+}
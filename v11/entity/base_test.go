@@ -35,7 +35,7 @@ func TestStatusMetaUnmarshalJSON(t *testing.T) {
 	require.Equal(t, true, status.GetOrigin("online"))
 	require.Equal(t, false, status.GetOrigin("good"))
 	require.Equal(t, "custom_value", status.GetOrigin("custom_field"))
-	require.InEpsilon(t, float64(42), status.GetOrigin("extra_number"), 0)
+	require.Equal(t, json.Number("42"), status.GetOrigin("extra_number"))
 
 	// 测试 SetOrigin
 	status.SetOrigin("new_field", "new_value")
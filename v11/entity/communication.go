@@ -3,7 +3,9 @@ package entity
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // ActionRequest 表示上报到传输层的动作请求.
@@ -69,6 +71,8 @@ func (r *ActionResponse[T]) ToActionRawResponse() (*ActionRawResponse, error) {
 	}, nil
 }
 
+// ActionError 表示动作执行失败的响应
+// 可通过 errors.Is 匹配 ErrActionFailed 以及 retcode 对应的错误，例如 ErrRateLimited.
 type ActionError struct {
 	UrlPath string
 	Status  ActionResponseStatus
@@ -83,3 +87,115 @@ func (e *ActionError) Error() string {
 
 	return fmt.Sprintf("action %s failed: status=%s retcode=%d message=%s", e.UrlPath, e.Status, e.Retcode, e.Message)
 }
+
+// Is 判断是否匹配 ErrActionFailed 或 retcode 对应的错误.
+func (e *ActionError) Is(target error) bool {
+	if e == nil {
+		return false
+	}
+
+	if target == ErrActionFailed {
+		return true
+	}
+
+	err := e.Retcode.Err()
+
+	return err != nil && err == target
+}
+
+// AsyncResult 表示 OneBot 实现已受理 <action>_async 或 <action>_rate_limited 动作，动作的执行结果不会返回
+// 实现直接执行动作并返回成功响应时同样视为已受理，Status 为 ok.
+type AsyncResult struct {
+	Action  string               `json:"action"`            // 实际调用的动作名，包含 _async 或 _rate_limited 后缀
+	Status  ActionResponseStatus `json:"status"`            // async | ok
+	Message string               `json:"message,omitempty"` // 可选，人类可读的提示信息
+}
+
+// IsAsync 判断是否为异步受理的动作响应（status 为 async、retcode 为 1），而不是执行失败.
+func (r *ActionRawResponse) IsAsync() bool {
+	return r.Status == StatusAsync || (r.Status != StatusFailed && r.Retcode == RetcodeAsync)
+}
+
+// Err 返回 retcode 对应的错误，成功、异步或未知的 retcode 返回 nil.
+func (r ActionResponseRetcode) Err() error {
+	return retcodeErrors[r]
+}
+
+//nolint:gochecknoglobals // 只读映射表
+var retcodeErrors = map[ActionResponseRetcode]error{
+	RetcodeInvalidParams:      ErrInvalidParams,
+	RetcodeEmptyResult:        ErrEmptyResult,
+	RetcodeOperationFailed:    ErrOperationFailed,
+	RetcodeCredentialsExpired: ErrCredentialsExpired,
+	RetcodeWorkerPoolNotReady: ErrWorkerPoolNotReady,
+	RetcodeBadRequest:         ErrBadRequest,
+	RetcodeUnauthorized:       ErrUnauthorized,
+	RetcodeForbidden:          ErrForbidden,
+	RetcodeNotFound:           ErrNotFound,
+	RetcodeTooManyRequests:    ErrRateLimited,
+	RetcodeInternalError:      ErrInternalError,
+}
+
+// HTTPStatusError 表示 HTTP 传输层返回了非 2xx 状态码
+// 可通过 errors.Is 匹配 ErrHTTPStatus 以及状态码对应的错误，例如 ErrNotFound.
+type HTTPStatusError struct {
+	UrlPath    string
+	StatusCode int
+	// 响应体内容，可能被截断
+	Body string
+}
+
+func (e *HTTPStatusError) Error() string {
+	if e == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s: %d %s", ErrHTTPStatus, e.StatusCode, e.Body)
+}
+
+// Is 判断是否匹配 ErrHTTPStatus 或状态码对应的错误.
+func (e *HTTPStatusError) Is(target error) bool {
+	if e == nil {
+		return false
+	}
+
+	if target == ErrHTTPStatus {
+		return true
+	}
+
+	err := RetcodeFromHTTPStatus(e.StatusCode).Err()
+
+	return err != nil && err == target
+}
+
+// RetcodeFromHTTPStatus 返回 HTTP 状态码对应的 WebSocket retcode（1000 + 状态码），2xx 返回 RetcodeSuccess.
+func RetcodeFromHTTPStatus(status int) ActionResponseRetcode {
+	if status >= http.StatusOK && status < http.StatusMultipleChoices {
+		return RetcodeSuccess
+	}
+
+	return ActionResponseRetcode(httpStatusRetcodeBase + status)
+}
+
+// httpStatusRetcodeBase WebSocket 传输层表示 HTTP 状态码的 retcode 基数.
+const httpStatusRetcodeBase = 1000
+
+// IsRateLimited 判断错误是否由调用频率限制导致.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsNotFound 判断错误是否由 API 不存在导致.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized 判断错误是否由缺少或错误的 access token 导致.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden)
+}
+
+// IsActionFailed 判断错误是否为 OneBot 实现返回的动作执行失败，而不是网络或协议错误.
+func IsActionFailed(err error) bool {
+	return errors.Is(err, ErrActionFailed)
+}
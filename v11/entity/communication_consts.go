@@ -13,4 +13,30 @@ type ActionResponseRetcode int
 const (
 	RetcodeSuccess ActionResponseRetcode = 0
 	RetcodeAsync   ActionResponseRetcode = 1
+	// RetcodeInvalidParams 参数缺失或参数无效.
+	RetcodeInvalidParams ActionResponseRetcode = 100
+	// RetcodeEmptyResult 参数有效但实现未能处理，或返回数据为空.
+	RetcodeEmptyResult ActionResponseRetcode = 102
+	// RetcodeOperationFailed 操作失败，例如发送消息失败.
+	RetcodeOperationFailed ActionResponseRetcode = 103
+	// RetcodeCredentialsExpired 凭证（cookies 等）失效导致操作失败.
+	RetcodeCredentialsExpired ActionResponseRetcode = 104
+	// RetcodeWorkerPoolNotReady 工作线程池未正确初始化，无法执行异步任务.
+	RetcodeWorkerPoolNotReady ActionResponseRetcode = 201
+)
+
+// WebSocket 传输层使用 1000 + HTTP 状态码表示与 HTTP 状态码对应的错误.
+const (
+	// RetcodeBadRequest 请求格式错误，对应 HTTP 400.
+	RetcodeBadRequest ActionResponseRetcode = 1400
+	// RetcodeUnauthorized 未提供 access token，对应 HTTP 401.
+	RetcodeUnauthorized ActionResponseRetcode = 1401
+	// RetcodeForbidden access token 不符合，对应 HTTP 403.
+	RetcodeForbidden ActionResponseRetcode = 1403
+	// RetcodeNotFound API 不存在，对应 HTTP 404.
+	RetcodeNotFound ActionResponseRetcode = 1404
+	// RetcodeTooManyRequests 调用频率超出限制，对应 HTTP 429.
+	RetcodeTooManyRequests ActionResponseRetcode = 1429
+	// RetcodeInternalError 处理请求时发生内部错误，对应 HTTP 500.
+	RetcodeInternalError ActionResponseRetcode = 1500
 )
@@ -279,3 +279,97 @@ func (r *ActionError) SetMessage(v string) *ActionError {
 	r.Message = v
 	return r
 }
+
+// GetAction
+// 实际调用的动作名，包含 _async 或 _rate_limited 后缀
+func (r *AsyncResult) GetAction() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Action
+}
+
+// SetAction
+// 实际调用的动作名，包含 _async 或 _rate_limited 后缀
+func (r *AsyncResult) SetAction(v string) *AsyncResult {
+	r.Action = v
+	return r
+}
+
+// GetStatus
+// async | ok
+func (r *AsyncResult) GetStatus() ActionResponseStatus {
+	if r == nil {
+		var zero ActionResponseStatus
+		return zero
+	}
+	return r.Status
+}
+
+// SetStatus
+// async | ok
+func (r *AsyncResult) SetStatus(v ActionResponseStatus) *AsyncResult {
+	r.Status = v
+	return r
+}
+
+// GetMessage
+// 可选，人类可读的提示信息
+func (r *AsyncResult) GetMessage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Message
+}
+
+// SetMessage
+// 可选，人类可读的提示信息
+func (r *AsyncResult) SetMessage(v string) *AsyncResult {
+	r.Message = v
+	return r
+}
+
+func (r *HTTPStatusError) GetUrlPath() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.UrlPath
+}
+
+func (r *HTTPStatusError) SetUrlPath(v string) *HTTPStatusError {
+	r.UrlPath = v
+	return r
+}
+
+func (r *HTTPStatusError) GetStatusCode() int {
+	if r == nil {
+		var zero int
+		return zero
+	}
+	return r.StatusCode
+}
+
+func (r *HTTPStatusError) SetStatusCode(v int) *HTTPStatusError {
+	r.StatusCode = v
+	return r
+}
+
+// GetBody
+// 响应体内容，可能被截断
+func (r *HTTPStatusError) GetBody() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Body
+}
+
+// SetBody
+// 响应体内容，可能被截断
+func (r *HTTPStatusError) SetBody(v string) *HTTPStatusError {
+	r.Body = v
+	return r
+}
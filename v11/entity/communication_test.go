@@ -3,6 +3,8 @@ package entity
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -108,3 +110,65 @@ func TestActionErrorError(t *testing.T) {
 	var nilErr *ActionError
 	require.Empty(t, nilErr.Error())
 }
+
+func TestActionErrorIs(t *testing.T) {
+	t.Parallel()
+
+	var err error = fmt.Errorf("call: %w", &ActionError{Status: StatusFailed, Retcode: RetcodeTooManyRequests})
+
+	require.ErrorIs(t, err, ErrActionFailed)
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.NotErrorIs(t, err, ErrNotFound)
+	require.NotErrorIs(t, err, ErrHTTPStatus)
+	require.True(t, IsRateLimited(err))
+	require.True(t, IsActionFailed(err))
+	require.False(t, IsNotFound(err))
+
+	err = &ActionError{Status: StatusFailed, Retcode: 114}
+	require.ErrorIs(t, err, ErrActionFailed)
+	require.False(t, IsRateLimited(err))
+
+	require.True(t, IsUnauthorized(&ActionError{Retcode: RetcodeForbidden}))
+	require.True(t, IsNotFound(&ActionError{Retcode: RetcodeNotFound}))
+	require.ErrorIs(t, &ActionError{Retcode: RetcodeCredentialsExpired}, ErrCredentialsExpired)
+
+	var nilErr *ActionError
+	require.False(t, nilErr.Is(ErrActionFailed))
+}
+
+func TestActionRawResponseIsAsync(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&ActionRawResponse{Status: StatusAsync, Retcode: RetcodeAsync}).IsAsync())
+	require.True(t, (&ActionRawResponse{Retcode: RetcodeAsync}).IsAsync())
+	require.False(t, (&ActionRawResponse{Status: StatusFailed, Retcode: RetcodeAsync}).IsAsync())
+	require.False(t, (&ActionRawResponse{Status: StatusOK}).IsAsync())
+}
+
+func TestHTTPStatusError(t *testing.T) {
+	t.Parallel()
+
+	err := &HTTPStatusError{UrlPath: "/send_msg", StatusCode: http.StatusNotFound, Body: "404 page not found"}
+
+	require.Equal(t, "unexpected http status: 404 404 page not found", err.Error())
+	require.ErrorIs(t, err, ErrHTTPStatus)
+	require.True(t, IsNotFound(err))
+	require.False(t, IsActionFailed(err))
+	require.True(t, IsRateLimited(&HTTPStatusError{StatusCode: http.StatusTooManyRequests}))
+	require.True(t, IsUnauthorized(&HTTPStatusError{StatusCode: http.StatusUnauthorized}))
+	require.NotErrorIs(t, &HTTPStatusError{StatusCode: http.StatusBadGateway}, ErrInternalError)
+
+	var nilErr *HTTPStatusError
+	require.Empty(t, nilErr.Error())
+	require.False(t, nilErr.Is(ErrHTTPStatus))
+}
+
+func TestRetcodeFromHTTPStatus(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, RetcodeSuccess, RetcodeFromHTTPStatus(http.StatusNoContent))
+	require.Equal(t, RetcodeBadRequest, RetcodeFromHTTPStatus(http.StatusBadRequest))
+	require.Equal(t, RetcodeInternalError, RetcodeFromHTTPStatus(http.StatusInternalServerError))
+	require.NoError(t, RetcodeSuccess.Err())
+	require.ErrorIs(t, RetcodeInvalidParams.Err(), ErrInvalidParams)
+}
@@ -0,0 +1,252 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+const (
+	cqCodePrefix = "[CQ:"
+	cqCodeSuffix = "]"
+)
+
+//nolint:gochecknoglobals // 转义规则为只读的包级变量
+var (
+	// cqTextEscaper CQ 码外纯文本的转义规则.
+	cqTextEscaper = strings.NewReplacer("&", "&amp;", "[", "&#91;", "]", "&#93;")
+	// cqParamEscaper CQ 码参数值的转义规则，额外转义逗号.
+	cqParamEscaper = strings.NewReplacer("&", "&amp;", "[", "&#91;", "]", "&#93;", ",", "&#44;")
+	// cqUnescaper 反转义规则，单次扫描保证 "&amp;#91;" 被还原为 "&#91;".
+	cqUnescaper = strings.NewReplacer("&#44;", ",", "&#91;", "[", "&#93;", "]", "&amp;", "&")
+)
+
+// EscapeCQText 转义 CQ 码外的纯文本（& [ ]）.
+func EscapeCQText(text string) string {
+	return cqTextEscaper.Replace(text)
+}
+
+// EscapeCQParam 转义 CQ 码内的参数值（& [ ] ,）.
+func EscapeCQParam(value string) string {
+	return cqParamEscaper.Replace(value)
+}
+
+// UnescapeCQ 反转义纯文本或参数值.
+func UnescapeCQ(text string) string {
+	return cqUnescaper.Replace(text)
+}
+
+// ParseCQString 将 CQ 码字符串解析为消息段数组
+// 纯文本解析为 text 消息段，已注册的 CQ 码类型解析为对应的数据类型，未注册的类型解析为 RawSegmentData.
+func ParseCQString(str string) ([]*Segment, error) {
+	segments := make([]*Segment, 0)
+
+	for str != "" {
+		start := strings.Index(str, cqCodePrefix)
+		if start < 0 {
+			segments = appendTextSegment(segments, str)
+
+			break
+		}
+
+		segments = appendTextSegment(segments, str[:start])
+
+		end := strings.Index(str[start:], cqCodeSuffix)
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated cq code: %s", ErrInvalidCQCode, str[start:])
+		}
+
+		segment, err := parseCQCode(str[start+len(cqCodePrefix) : start+end])
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, segment)
+		str = str[start+end+len(cqCodeSuffix):]
+	}
+
+	return segments, nil
+}
+
+// FormatCQString 将消息段数组序列化为 CQ 码字符串.
+func FormatCQString(segments []*Segment) (string, error) {
+	var builder strings.Builder
+
+	for _, segment := range segments {
+		code, err := segment.CQCode()
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteString(code)
+	}
+
+	return builder.String(), nil
+}
+
+// CQCode 将消息段序列化为 CQ 码，text 消息段序列化为转义后的纯文本.
+func (s *Segment) CQCode() (string, error) {
+	if s == nil {
+		return "", nil
+	}
+
+	if text, ok := s.Data.(*TextSegmentData); ok {
+		return EscapeCQText(text.Text), nil
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString(cqCodePrefix)
+	builder.WriteString(string(s.Type))
+
+	if s.Data != nil {
+		params, err := cqCodeParams(s.Data)
+		if err != nil {
+			return "", fmt.Errorf("format %s segment: %w", s.Type, err)
+		}
+
+		for _, param := range params {
+			builder.WriteString(",")
+			builder.WriteString(param[0])
+			builder.WriteString("=")
+			builder.WriteString(EscapeCQParam(param[1]))
+		}
+	}
+
+	builder.WriteString(cqCodeSuffix)
+
+	return builder.String(), nil
+}
+
+func appendTextSegment(segments []*Segment, text string) []*Segment {
+	if text == "" {
+		return segments
+	}
+
+	return append(segments, NewSegment(&TextSegmentData{Text: UnescapeCQ(text)}))
+}
+
+// parseCQCode 解析去掉 "[CQ:" 与 "]" 后的 CQ 码内容，例如 "image,file=a.jpg".
+func parseCQCode(body string) (*Segment, error) {
+	parts := strings.Split(body, ",")
+
+	typ := SegmentDataType(strings.TrimSpace(parts[0]))
+	if typ == "" {
+		return nil, fmt.Errorf("%w: missing type in [CQ:%s]", ErrInvalidCQCode, body)
+	}
+
+	params := make(map[string]string, len(parts)-1)
+
+	for _, part := range parts[1:] {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: invalid param %q in [CQ:%s]", ErrInvalidCQCode, part, body)
+		}
+
+		params[key] = UnescapeCQ(value)
+	}
+
+	data, err := decodeCQSegmentData(typ, params)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode [CQ:%s]: %w", ErrInvalidCQCode, typ, err)
+	}
+
+	return &Segment{Type: typ, Data: data}, nil
+}
+
+func decodeCQSegmentData(typ SegmentDataType, params map[string]string) (SegmentData, error) {
+	data := newSegmentData(typ)
+
+	if raw, ok := data.(*RawSegmentData); ok {
+		bs, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("marshal params: %w", err)
+		}
+
+		raw.Data = bs
+
+		return raw, nil
+	}
+
+	err := util.JsonTagMappingWeak(params, data, cqMessageValueDecodeHook)
+	if err != nil {
+		return nil, fmt.Errorf("map params: %w", err)
+	}
+
+	return data, nil
+}
+
+// cqMessageValueDecodeHook 将 CQ 码参数中的字符串转换为字符串形式的 MessageValue（例如 node 的 content）.
+func cqMessageValueDecodeHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeFor[MessageValue]() {
+		return data, nil
+	}
+
+	return MessageValue{Type: MessageValueTypeString, StringValue: fmt.Sprint(data)}, nil
+}
+
+// cqCodeParams 按 JSON 字段顺序提取消息段数据的参数，null 值会被忽略.
+func cqCodeParams(data SegmentData) ([][2]string, error) {
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("marshal data: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("read data: %w", err)
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("%w: segment data is not an object", ErrInvalidCQCode)
+	}
+
+	var params [][2]string
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("read key: %w", err)
+		}
+
+		var value json.RawMessage
+
+		err = dec.Decode(&value)
+		if err != nil {
+			return nil, fmt.Errorf("read value: %w", err)
+		}
+
+		str, ok := cqParamValue(value)
+		if !ok {
+			continue
+		}
+
+		params = append(params, [2]string{fmt.Sprint(keyTok), str})
+	}
+
+	return params, nil
+}
+
+// cqParamValue 将 JSON 值转换为 CQ 码参数值：字符串去引号，数字/布尔原样输出，对象/数组输出紧凑 JSON.
+func cqParamValue(value json.RawMessage) (string, bool) {
+	if len(value) == 0 || bytes.Equal(value, []byte("null")) {
+		return "", false
+	}
+
+	if value[0] == '"' {
+		var str string
+
+		err := json.Unmarshal(value, &str)
+		if err == nil {
+			return str, true
+		}
+	}
+
+	return string(value), true
+}
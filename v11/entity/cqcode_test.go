@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCQEscape(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		raw       string
+		wantText  string
+		wantParam string
+	}{
+		{name: "plain", raw: "hello", wantText: "hello", wantParam: "hello"},
+		{name: "brackets", raw: "[a]", wantText: "&#91;a&#93;", wantParam: "&#91;a&#93;"},
+		{name: "ampersand", raw: "a&b", wantText: "a&amp;b", wantParam: "a&amp;b"},
+		{name: "comma", raw: "a,b", wantText: "a,b", wantParam: "a&#44;b"},
+		{name: "escaped_entity", raw: "&#91;", wantText: "&amp;#91;", wantParam: "&amp;#91;"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.wantText, EscapeCQText(tc.raw))
+			require.Equal(t, tc.wantParam, EscapeCQParam(tc.raw))
+			require.Equal(t, tc.raw, UnescapeCQ(tc.wantText))
+			require.Equal(t, tc.raw, UnescapeCQ(tc.wantParam))
+		})
+	}
+}
+
+func TestParseCQString(t *testing.T) {
+	t.Parallel()
+
+	cacheNo := CacheFlagNo
+
+	segments, err := ParseCQString(
+		"[CQ:reply,id=123]hi &#91;x&#93; [CQ:at,qq=10001][CQ:image,file=a&#44;b.jpg,cache=0]" +
+			"[CQ:poke,type=126,id=2011][CQ:mface,emoji_id=abc]tail",
+	)
+	require.NoError(t, err)
+	require.Equal(t, []*Segment{
+		NewSegment(&ReplySegmentData{Id: "123"}),
+		NewSegment(&TextSegmentData{Text: "hi [x] "}),
+		NewSegment(&AtSegmentData{QQ: "10001"}),
+		NewSegment(&ImageSegmentData{File: "a,b.jpg", Cache: &cacheNo}),
+		NewSegment(&PokeSegmentData{Type: "126", Id: 2011}),
+		NewSegment(&RawSegmentData{Type: "mface", Data: []byte(`{"emoji_id":"abc"}`)}),
+		NewSegment(&TextSegmentData{Text: "tail"}),
+	}, segments)
+}
+
+func TestParseCQStringNodeContent(t *testing.T) {
+	t.Parallel()
+
+	segments, err := ParseCQString("[CQ:node,user_id=1,nickname=n,content=hi&#44;there]")
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	require.Equal(t, &NodeSegmentData{
+		UserId:   "1",
+		Nickname: "n",
+		Content:  &MessageValue{Type: MessageValueTypeString, StringValue: "hi,there"},
+	}, segments[0].Data)
+}
+
+func TestParseCQStringEmpty(t *testing.T) {
+	t.Parallel()
+
+	segments, err := ParseCQString("")
+	require.NoError(t, err)
+	require.Empty(t, segments)
+}
+
+func TestParseCQStringInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+	}{
+		{name: "unterminated", input: "hi [CQ:face,id=1"},
+		{name: "missing_type", input: "[CQ:,id=1]"},
+		{name: "param_without_value", input: "[CQ:face,id]"},
+		{name: "bad_typed_value", input: "[CQ:image,file=a.jpg,timeout=abc]"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseCQString(tc.input)
+			require.ErrorIs(t, err, ErrInvalidCQCode)
+		})
+	}
+}
+
+func TestFormatCQString(t *testing.T) {
+	t.Parallel()
+
+	title := "t,1"
+
+	str, err := FormatCQString([]*Segment{
+		NewSegment(&ReplySegmentData{Id: "123"}),
+		NewSegment(&TextSegmentData{Text: "a&b [c]"}),
+		NewSegment(&AtSegmentData{QQ: "all"}),
+		NewSegment(&RpsSegmentData{}),
+		NewSegment(&ShareSegmentData{Url: "http://x/?a=1&b=2", Title: "t", Content: &title}),
+		NewSegment(PokeSegmentDataPoke()),
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		"[CQ:reply,id=123]a&amp;b &#91;c&#93;[CQ:at,qq=all][CQ:rps]"+
+			"[CQ:share,url=http://x/?a=1&amp;b=2,title=t,content=t&#44;1][CQ:poke,type=戳一戳,id=1,name=-1]",
+		str,
+	)
+}
+
+func TestCQStringRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	input := "[CQ:face,id=14]&#91;CQ:fake&#93; &amp; [CQ:image,file=x.jpg,type=flash,url=http://a/b&#44;c]"
+
+	segments, err := ParseCQString(input)
+	require.NoError(t, err)
+
+	out, err := FormatCQString(segments)
+	require.NoError(t, err)
+	require.Equal(t, input, out)
+}
+
+func TestMessageValueSegmentsAndCQString(t *testing.T) {
+	t.Parallel()
+
+	strValue := &MessageValue{Type: MessageValueTypeString, StringValue: "hi[CQ:face,id=1]"}
+	arrValue := &MessageValue{Type: MessageValueTypeArray, ArrayValue: []*Segment{
+		NewSegment(&TextSegmentData{Text: "hi"}),
+		NewSegment(&FaceSegmentData{Id: "1"}),
+	}}
+
+	segments, err := strValue.Segments()
+	require.NoError(t, err)
+	require.Equal(t, arrValue.ArrayValue, segments)
+
+	segments, err = arrValue.Segments()
+	require.NoError(t, err)
+	require.Equal(t, arrValue.ArrayValue, segments)
+
+	str, err := arrValue.CQString()
+	require.NoError(t, err)
+	require.Equal(t, strValue.StringValue, str)
+
+	str, err = strValue.CQString()
+	require.NoError(t, err)
+	require.Equal(t, strValue.StringValue, str)
+
+	var nilValue *MessageValue
+
+	segments, err = nilValue.Segments()
+	require.NoError(t, err)
+	require.Nil(t, segments)
+
+	str, err = nilValue.CQString()
+	require.NoError(t, err)
+	require.Empty(t, str)
+}
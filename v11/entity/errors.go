@@ -0,0 +1,56 @@
+package entity
+
+import "errors"
+
+var (
+	// ErrInvalidCQCode 表示 CQ 码格式不合法.
+	ErrInvalidCQCode = errors.New("invalid cq code")
+	// ErrMissingTypeField 表示事件缺少类型字段.
+	ErrMissingTypeField = errors.New("missing type field")
+	// ErrUnknownEventType 表示未知的事件类型.
+	ErrUnknownEventType = errors.New("unknown event type")
+	// ErrMissingOrInvalidPostType 表示缺少或无效的 post_type 字段.
+	ErrMissingOrInvalidPostType = errors.New("missing or invalid post_type field")
+	// ErrUnknownPostType 表示未知的 post_type.
+	ErrUnknownPostType = errors.New("unknown post_type")
+	// ErrInvalidEventPath 表示事件类型注册路径不合法.
+	ErrInvalidEventPath = errors.New("invalid event path")
+)
+
+// 调用动作失败的分类，可通过 errors.Is 区分网络错误、HTTP 状态错误、协议错误与动作执行失败.
+var (
+	// ErrNetwork 表示与 OneBot 实现通信时发生网络错误.
+	ErrNetwork = errors.New("network error")
+	// ErrHTTPStatus 表示 HTTP 响应状态码不是 2xx.
+	ErrHTTPStatus = errors.New("unexpected http status")
+	// ErrProtocol 表示响应不符合 OneBot 协议，例如无法解析的响应体.
+	ErrProtocol = errors.New("protocol error")
+	// ErrActionFailed 表示动作执行失败，即响应的 status 为 failed 或 retcode 不为 0（异步受理的 retcode 1 除外）.
+	ErrActionFailed = errors.New("action failed")
+)
+
+// 与 retcode 对应的错误，ActionError 与 HTTPStatusError 可通过 errors.Is 匹配.
+var (
+	// ErrInvalidParams 对应 retcode 100.
+	ErrInvalidParams = errors.New("invalid params")
+	// ErrEmptyResult 对应 retcode 102.
+	ErrEmptyResult = errors.New("empty result")
+	// ErrOperationFailed 对应 retcode 103.
+	ErrOperationFailed = errors.New("operation failed")
+	// ErrCredentialsExpired 对应 retcode 104.
+	ErrCredentialsExpired = errors.New("credentials expired")
+	// ErrWorkerPoolNotReady 对应 retcode 201.
+	ErrWorkerPoolNotReady = errors.New("worker pool not ready")
+	// ErrBadRequest 对应 retcode 1400 或 HTTP 400.
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized 对应 retcode 1401 或 HTTP 401.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden 对应 retcode 1403 或 HTTP 403.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound 对应 retcode 1404 或 HTTP 404.
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited 对应 retcode 1429 或 HTTP 429.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrInternalError 对应 retcode 1500 或 HTTP 500.
+	ErrInternalError = errors.New("internal error")
+)
@@ -35,6 +35,8 @@ type PrivateMessageEvent struct {
 	Font int64 `json:"font"`
 	// 发送人信息
 	Sender *PrivateMessageEventSender `json:"sender"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 type PrivateMessageEventSender struct {
@@ -46,6 +48,8 @@ type PrivateMessageEventSender struct {
 	Sex SexType `json:"sex"`
 	// 年龄
 	Age int64 `json:"age"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupMessageEvent 群消息
@@ -80,6 +84,8 @@ type GroupMessageEvent struct {
 	Font int64 `json:"font"`
 	// 发送人信息
 	Sender *GroupMessageEventSender `json:"sender"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 type GroupMessageEventSender struct {
@@ -101,6 +107,8 @@ type GroupMessageEventSender struct {
 	Role GroupMemberRoleType `json:"role"`
 	// 专属头衔
 	Title string `json:"title"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupFileUploadEvent 群文件上传
@@ -121,6 +129,8 @@ type GroupFileUploadEvent struct {
 	UserId int64 `json:"user_id"`
 	// 文件信息
 	File *GroupFileUploadEventFile `json:"file"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 type GroupFileUploadEventFile struct {
@@ -132,6 +142,8 @@ type GroupFileUploadEventFile struct {
 	Size int64 `json:"size"`
 	// busid（目前不清楚有什么作用）
 	BusId int64 `json:"busid"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupAdminChangeEvent 群管理员变动
@@ -153,6 +165,8 @@ type GroupAdminChangeEvent struct {
 	GroupId int64 `json:"group_id"`
 	// 管理员 QQ 号
 	UserId int64 `json:"user_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupMemberDecreaseEvent 群成员减少
@@ -176,6 +190,8 @@ type GroupMemberDecreaseEvent struct {
 	OperatorId int64 `json:"operator_id"`
 	// 离开者 QQ 号
 	UserId int64 `json:"user_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupMemberIncreaseEvent 群成员增加
@@ -199,6 +215,8 @@ type GroupMemberIncreaseEvent struct {
 	OperatorId int64 `json:"operator_id"`
 	// 加入者 QQ 号
 	UserId int64 `json:"user_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupBanEvent 群禁言
@@ -224,6 +242,8 @@ type GroupBanEvent struct {
 	UserId int64 `json:"user_id"`
 	// 禁言时长，单位秒
 	Duration int64 `json:"duration"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // FriendAddEvent 好友添加
@@ -240,6 +260,8 @@ type FriendAddEvent struct {
 
 	// 新添加好友 QQ 号
 	UserId int64 `json:"user_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupRecallEvent 群消息撤回
@@ -262,6 +284,8 @@ type GroupRecallEvent struct {
 	OperatorId int64 `json:"operator_id"`
 	// 被撤回的消息 ID
 	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // FriendRecallEvent 好友消息撤回
@@ -280,6 +304,8 @@ type FriendRecallEvent struct {
 	UserId int64 `json:"user_id"`
 	// 被撤回的消息 ID
 	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupPokeEvent 群内戳一戳
@@ -303,6 +329,8 @@ type GroupPokeEvent struct {
 	UserId int64 `json:"user_id"`
 	// 被戳者 QQ 号
 	TargetId int64 `json:"target_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupLuckyKingEvent 群红包运气王
@@ -326,6 +354,8 @@ type GroupLuckyKingEvent struct {
 	UserId int64 `json:"user_id"`
 	// 运气王 QQ 号
 	TargetId int64 `json:"target_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupHonorChangeEvent 群成员荣誉变更
@@ -349,6 +379,8 @@ type GroupHonorChangeEvent struct {
 	HonorType EventGroupHonorChangeHonorType `json:"honor_type"`
 	// 成员 QQ 号
 	UserId int64 `json:"user_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // FriendRequestEvent 加好友请求
@@ -369,6 +401,8 @@ type FriendRequestEvent struct {
 	Comment string `json:"comment"`
 	// 请求 flag，在调用处理请求的 API 时需要传入
 	Flag string `json:"flag"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // GroupRequestEvent 加群请求／邀请
@@ -394,6 +428,8 @@ type GroupRequestEvent struct {
 	Comment string `json:"comment"`
 	// 请求 flag，在调用处理请求的 API 时需要传入
 	Flag string `json:"flag"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // LifecycleEvent 生命周期
@@ -411,6 +447,8 @@ type LifecycleEvent struct {
 
 	// 事件子类型，分别表示 OneBot 启用、停用、WebSocket 连接成功 | 可能的值: enable, disable, connect
 	SubType EventLifecycleSubType `json:"sub_type"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
 
 // HeartbeatEvent 心跳
@@ -429,4 +467,6 @@ type HeartbeatEvent struct {
 	Status *StatusMeta `json:"status"`
 	// 到下次心跳的间隔，单位毫秒
 	Interval int64 `json:"interval"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
 }
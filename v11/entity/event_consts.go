@@ -7,6 +7,8 @@ const (
 	EventPostTypeNotice    EventPostType = "notice"     // 通知事件
 	EventPostTypeRequest   EventPostType = "request"    // 请求事件
 	EventPostTypeMetaEvent EventPostType = "meta_event" // 元事件
+	// EventPostTypeMessageSent 机器人自身发送的消息，go-cqhttp / NapCat 等实现扩展.
+	EventPostTypeMessageSent EventPostType = "message_sent"
 )
 
 type EventMessageType string // 消息类型
@@ -36,6 +38,13 @@ const (
 	EventNoticeTypeGroupRecall   EventNoticeType = "group_recall"
 	EventNoticeTypeFriendRecall  EventNoticeType = "friend_recall"
 	EventNoticeTypeNotify        EventNoticeType = "notify"
+
+	// go-cqhttp / NapCat 等实现扩展的通知类型.
+	EventNoticeTypeGroupCard         EventNoticeType = "group_card"
+	EventNoticeTypeOfflineFile       EventNoticeType = "offline_file"
+	EventNoticeTypeClientStatus      EventNoticeType = "client_status"
+	EventNoticeTypeEssence           EventNoticeType = "essence"
+	EventNoticeTypeGroupMsgEmojiLike EventNoticeType = "group_msg_emoji_like"
 )
 
 type EventGroupMessageSubType string
@@ -81,6 +90,14 @@ const (
 	EventNoticeSubTypeGroupPoke      EventNoticeSubType = "poke"
 	EventNoticeSubTypeGroupLuckyKing EventNoticeSubType = "lucky_king"
 	EventNoticeSubTypeGroupHonor     EventNoticeSubType = "honor"
+	EventNoticeSubTypeInputStatus    EventNoticeSubType = "input_status"
+)
+
+type EventEssenceSubType string
+
+const (
+	EventEssenceSubTypeAdd    EventEssenceSubType = "add"
+	EventEssenceSubTypeDelete EventEssenceSubType = "delete"
 )
 
 type EventGroupHonorChangeHonorType string
@@ -0,0 +1,234 @@
+//go:generate go run ../cmd/entity-gen
+package entity
+
+// 本文件定义 go-cqhttp / NapCat 等实现扩展的事件，不属于 OneBot 11 标准.
+
+// PrivateMessageSentEvent 机器人自身发送的私聊消息
+// 上报类型: message_sent
+// 事件类型: private.
+type PrivateMessageSentEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: message_sent
+	PostType EventPostType `json:"post_type"`
+	// 消息类型 | 可能的值: private
+	MessageType EventMessageType `json:"message_type"`
+
+	// 消息子类型 | 可能的值: friend, group, other
+	SubType EventPrivateMessageSubType `json:"sub_type"`
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 发送者 QQ 号，即机器人自身
+	UserId int64 `json:"user_id"`
+	// 接收者 QQ 号
+	TargetId int64 `json:"target_id"`
+	// 消息内容
+	// 可以是字符串 (CQ 码格式) 或消息段数组
+	Message *MessageValue `json:"message"`
+	// 原始消息内容
+	RawMessage string `json:"raw_message"`
+	// 字体
+	Font int64 `json:"font"`
+	// 发送人信息
+	Sender *PrivateMessageEventSender `json:"sender"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GroupMessageSentEvent 机器人自身发送的群消息
+// 上报类型: message_sent
+// 事件类型: group.
+type GroupMessageSentEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: message_sent
+	PostType EventPostType `json:"post_type"`
+	// 消息类型 | 可能的值: group
+	MessageType EventMessageType `json:"message_type"`
+
+	// 消息子类型 | 可能的值: normal
+	SubType EventGroupMessageSubType `json:"sub_type"`
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 发送者 QQ 号，即机器人自身
+	UserId int64 `json:"user_id"`
+	// 消息内容
+	// 可以是字符串 (CQ 码格式) 或消息段数组
+	Message *MessageValue `json:"message"`
+	// 原始消息内容
+	RawMessage string `json:"raw_message"`
+	// 字体
+	Font int64 `json:"font"`
+	// 发送人信息
+	Sender *GroupMessageEventSender `json:"sender"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GroupCardEvent 群成员名片变更
+// 事件类型: group_card.
+type GroupCardEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 通知类型 | 可能的值: group_card
+	NoticeType EventNoticeType `json:"notice_type"`
+
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 成员 QQ 号
+	UserId int64 `json:"user_id"`
+	// 新名片
+	CardNew string `json:"card_new"`
+	// 旧名片
+	CardOld string `json:"card_old"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// OfflineFileEvent 接收到离线文件
+// 事件类型: offline_file.
+type OfflineFileEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 通知类型 | 可能的值: offline_file
+	NoticeType EventNoticeType `json:"notice_type"`
+
+	// 发送者 QQ 号
+	UserId int64 `json:"user_id"`
+	// 文件数据
+	File *OfflineFileEventFile `json:"file"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+type OfflineFileEventFile struct {
+	// 文件名
+	Name string `json:"name"`
+	// 文件大小
+	Size int64 `json:"size"`
+	// 下载链接
+	Url string `json:"url"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// ClientStatusEvent 其他客户端在线状态变更
+// 事件类型: client_status.
+type ClientStatusEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 通知类型 | 可能的值: client_status
+	NoticeType EventNoticeType `json:"notice_type"`
+
+	// 客户端信息
+	Client *OnlineClient `json:"client"`
+	// 当前是否在线
+	Online bool `json:"online"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// EssenceEvent 精华消息变更
+// 事件类型: essence
+// 子类型: add,delete.
+type EssenceEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 通知类型 | 可能的值: essence
+	NoticeType EventNoticeType `json:"notice_type"`
+
+	// 事件子类型，分别表示添加和移出精华消息 | 可能的值: add, delete
+	SubType EventEssenceSubType `json:"sub_type"`
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 消息发送者 QQ 号
+	SenderId int64 `json:"sender_id"`
+	// 操作者 QQ 号
+	OperatorId int64 `json:"operator_id"`
+	// 消息 ID
+	MessageId int64 `json:"message_id"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// GroupMsgEmojiLikeEvent 群消息表情回应
+// 事件类型: group_msg_emoji_like.
+type GroupMsgEmojiLikeEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 通知类型 | 可能的值: group_msg_emoji_like
+	NoticeType EventNoticeType `json:"notice_type"`
+
+	// 群号
+	GroupId int64 `json:"group_id"`
+	// 回应者 QQ 号
+	UserId int64 `json:"user_id"`
+	// 被回应的消息 ID
+	MessageId int64 `json:"message_id"`
+	// 表情回应列表
+	Likes []*GroupMsgEmojiLike `json:"likes"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+type GroupMsgEmojiLike struct {
+	// 表情 ID
+	EmojiId string `json:"emoji_id"`
+	// 回应数量
+	Count int64 `json:"count"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
+
+// InputStatusEvent 对方正在输入
+// 事件类型: notify
+// 子类型: input_status.
+type InputStatusEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型 | 可能的值: notice
+	PostType EventPostType `json:"post_type"`
+	// 消息类型 | 可能的值: notify
+	NoticeType EventNoticeType `json:"notice_type"`
+	// 提示类型 | 可能的值: input_status
+	SubType EventNoticeSubType `json:"sub_type"`
+
+	// 对方 QQ 号
+	UserId int64 `json:"user_id"`
+	// 群号，私聊时为 0
+	GroupId int64 `json:"group_id"`
+	// 状态文本，例如 `对方正在输入...`
+	StatusText string `json:"status_text"`
+	// 状态类型，1 表示正在输入，0 表示停止输入
+	EventType int64 `json:"event_type"`
+	// 原始字段的值，只能通过 GetOrigin / SetOrigin 方法访问
+	origin map[string]any
+}
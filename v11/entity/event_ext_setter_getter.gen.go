@@ -0,0 +1,1688 @@
+// Code generated by entity-gen. DO NOT EDIT.
+
+package entity
+
+import (
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// GetTime
+// 事件发生的时间戳
+func (r *PrivateMessageSentEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *PrivateMessageSentEvent) SetTime(v int64) *PrivateMessageSentEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *PrivateMessageSentEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *PrivateMessageSentEvent) SetSelfId(v int64) *PrivateMessageSentEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: message_sent
+func (r *PrivateMessageSentEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: message_sent
+func (r *PrivateMessageSentEvent) SetPostType(v EventPostType) *PrivateMessageSentEvent {
+	r.PostType = v
+	return r
+}
+
+// GetMessageType
+// 消息类型 | 可能的值: private
+func (r *PrivateMessageSentEvent) GetMessageType() EventMessageType {
+	if r == nil {
+		var zero EventMessageType
+		return zero
+	}
+	return r.MessageType
+}
+
+// SetMessageType
+// 消息类型 | 可能的值: private
+func (r *PrivateMessageSentEvent) SetMessageType(v EventMessageType) *PrivateMessageSentEvent {
+	r.MessageType = v
+	return r
+}
+
+// GetSubType
+// 消息子类型 | 可能的值: friend, group, other
+func (r *PrivateMessageSentEvent) GetSubType() EventPrivateMessageSubType {
+	if r == nil {
+		var zero EventPrivateMessageSubType
+		return zero
+	}
+	return r.SubType
+}
+
+// SetSubType
+// 消息子类型 | 可能的值: friend, group, other
+func (r *PrivateMessageSentEvent) SetSubType(v EventPrivateMessageSubType) *PrivateMessageSentEvent {
+	r.SubType = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *PrivateMessageSentEvent) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *PrivateMessageSentEvent) SetMessageId(v int64) *PrivateMessageSentEvent {
+	r.MessageId = v
+	return r
+}
+
+// GetUserId
+// 发送者 QQ 号，即机器人自身
+func (r *PrivateMessageSentEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 发送者 QQ 号，即机器人自身
+func (r *PrivateMessageSentEvent) SetUserId(v int64) *PrivateMessageSentEvent {
+	r.UserId = v
+	return r
+}
+
+// GetTargetId
+// 接收者 QQ 号
+func (r *PrivateMessageSentEvent) GetTargetId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.TargetId
+}
+
+// SetTargetId
+// 接收者 QQ 号
+func (r *PrivateMessageSentEvent) SetTargetId(v int64) *PrivateMessageSentEvent {
+	r.TargetId = v
+	return r
+}
+
+// GetMessage
+// 消息内容
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *PrivateMessageSentEvent) GetMessage() *MessageValue {
+	if r == nil {
+		var zero *MessageValue
+		return zero
+	}
+	return r.Message
+}
+
+// SetMessage
+// 消息内容
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *PrivateMessageSentEvent) SetMessage(v *MessageValue) *PrivateMessageSentEvent {
+	r.Message = v
+	return r
+}
+
+// GetRawMessage
+// 原始消息内容
+func (r *PrivateMessageSentEvent) GetRawMessage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.RawMessage
+}
+
+// SetRawMessage
+// 原始消息内容
+func (r *PrivateMessageSentEvent) SetRawMessage(v string) *PrivateMessageSentEvent {
+	r.RawMessage = v
+	return r
+}
+
+// GetFont
+// 字体
+func (r *PrivateMessageSentEvent) GetFont() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Font
+}
+
+// SetFont
+// 字体
+func (r *PrivateMessageSentEvent) SetFont(v int64) *PrivateMessageSentEvent {
+	r.Font = v
+	return r
+}
+
+// GetSender
+// 发送人信息
+func (r *PrivateMessageSentEvent) GetSender() *PrivateMessageEventSender {
+	if r == nil {
+		var zero *PrivateMessageEventSender
+		return zero
+	}
+	return r.Sender
+}
+
+// SetSender
+// 发送人信息
+func (r *PrivateMessageSentEvent) SetSender(v *PrivateMessageEventSender) *PrivateMessageSentEvent {
+	r.Sender = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *GroupMessageSentEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *GroupMessageSentEvent) SetTime(v int64) *GroupMessageSentEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupMessageSentEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupMessageSentEvent) SetSelfId(v int64) *GroupMessageSentEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: message_sent
+func (r *GroupMessageSentEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: message_sent
+func (r *GroupMessageSentEvent) SetPostType(v EventPostType) *GroupMessageSentEvent {
+	r.PostType = v
+	return r
+}
+
+// GetMessageType
+// 消息类型 | 可能的值: group
+func (r *GroupMessageSentEvent) GetMessageType() EventMessageType {
+	if r == nil {
+		var zero EventMessageType
+		return zero
+	}
+	return r.MessageType
+}
+
+// SetMessageType
+// 消息类型 | 可能的值: group
+func (r *GroupMessageSentEvent) SetMessageType(v EventMessageType) *GroupMessageSentEvent {
+	r.MessageType = v
+	return r
+}
+
+// GetSubType
+// 消息子类型 | 可能的值: normal
+func (r *GroupMessageSentEvent) GetSubType() EventGroupMessageSubType {
+	if r == nil {
+		var zero EventGroupMessageSubType
+		return zero
+	}
+	return r.SubType
+}
+
+// SetSubType
+// 消息子类型 | 可能的值: normal
+func (r *GroupMessageSentEvent) SetSubType(v EventGroupMessageSubType) *GroupMessageSentEvent {
+	r.SubType = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *GroupMessageSentEvent) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *GroupMessageSentEvent) SetMessageId(v int64) *GroupMessageSentEvent {
+	r.MessageId = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GroupMessageSentEvent) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GroupMessageSentEvent) SetGroupId(v int64) *GroupMessageSentEvent {
+	r.GroupId = v
+	return r
+}
+
+// GetUserId
+// 发送者 QQ 号，即机器人自身
+func (r *GroupMessageSentEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 发送者 QQ 号，即机器人自身
+func (r *GroupMessageSentEvent) SetUserId(v int64) *GroupMessageSentEvent {
+	r.UserId = v
+	return r
+}
+
+// GetMessage
+// 消息内容
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *GroupMessageSentEvent) GetMessage() *MessageValue {
+	if r == nil {
+		var zero *MessageValue
+		return zero
+	}
+	return r.Message
+}
+
+// SetMessage
+// 消息内容
+// 可以是字符串 (CQ 码格式) 或消息段数组
+func (r *GroupMessageSentEvent) SetMessage(v *MessageValue) *GroupMessageSentEvent {
+	r.Message = v
+	return r
+}
+
+// GetRawMessage
+// 原始消息内容
+func (r *GroupMessageSentEvent) GetRawMessage() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.RawMessage
+}
+
+// SetRawMessage
+// 原始消息内容
+func (r *GroupMessageSentEvent) SetRawMessage(v string) *GroupMessageSentEvent {
+	r.RawMessage = v
+	return r
+}
+
+// GetFont
+// 字体
+func (r *GroupMessageSentEvent) GetFont() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Font
+}
+
+// SetFont
+// 字体
+func (r *GroupMessageSentEvent) SetFont(v int64) *GroupMessageSentEvent {
+	r.Font = v
+	return r
+}
+
+// GetSender
+// 发送人信息
+func (r *GroupMessageSentEvent) GetSender() *GroupMessageEventSender {
+	if r == nil {
+		var zero *GroupMessageEventSender
+		return zero
+	}
+	return r.Sender
+}
+
+// SetSender
+// 发送人信息
+func (r *GroupMessageSentEvent) SetSender(v *GroupMessageEventSender) *GroupMessageSentEvent {
+	r.Sender = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *GroupCardEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *GroupCardEvent) SetTime(v int64) *GroupCardEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupCardEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupCardEvent) SetSelfId(v int64) *GroupCardEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *GroupCardEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *GroupCardEvent) SetPostType(v EventPostType) *GroupCardEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 通知类型 | 可能的值: group_card
+func (r *GroupCardEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 通知类型 | 可能的值: group_card
+func (r *GroupCardEvent) SetNoticeType(v EventNoticeType) *GroupCardEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GroupCardEvent) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GroupCardEvent) SetGroupId(v int64) *GroupCardEvent {
+	r.GroupId = v
+	return r
+}
+
+// GetUserId
+// 成员 QQ 号
+func (r *GroupCardEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 成员 QQ 号
+func (r *GroupCardEvent) SetUserId(v int64) *GroupCardEvent {
+	r.UserId = v
+	return r
+}
+
+// GetCardNew
+// 新名片
+func (r *GroupCardEvent) GetCardNew() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.CardNew
+}
+
+// SetCardNew
+// 新名片
+func (r *GroupCardEvent) SetCardNew(v string) *GroupCardEvent {
+	r.CardNew = v
+	return r
+}
+
+// GetCardOld
+// 旧名片
+func (r *GroupCardEvent) GetCardOld() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.CardOld
+}
+
+// SetCardOld
+// 旧名片
+func (r *GroupCardEvent) SetCardOld(v string) *GroupCardEvent {
+	r.CardOld = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *OfflineFileEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *OfflineFileEvent) SetTime(v int64) *OfflineFileEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *OfflineFileEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *OfflineFileEvent) SetSelfId(v int64) *OfflineFileEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *OfflineFileEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *OfflineFileEvent) SetPostType(v EventPostType) *OfflineFileEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 通知类型 | 可能的值: offline_file
+func (r *OfflineFileEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 通知类型 | 可能的值: offline_file
+func (r *OfflineFileEvent) SetNoticeType(v EventNoticeType) *OfflineFileEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetUserId
+// 发送者 QQ 号
+func (r *OfflineFileEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 发送者 QQ 号
+func (r *OfflineFileEvent) SetUserId(v int64) *OfflineFileEvent {
+	r.UserId = v
+	return r
+}
+
+// GetFile
+// 文件数据
+func (r *OfflineFileEvent) GetFile() *OfflineFileEventFile {
+	if r == nil {
+		var zero *OfflineFileEventFile
+		return zero
+	}
+	return r.File
+}
+
+// SetFile
+// 文件数据
+func (r *OfflineFileEvent) SetFile(v *OfflineFileEventFile) *OfflineFileEvent {
+	r.File = v
+	return r
+}
+
+// GetName
+// 文件名
+func (r *OfflineFileEventFile) GetName() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Name
+}
+
+// SetName
+// 文件名
+func (r *OfflineFileEventFile) SetName(v string) *OfflineFileEventFile {
+	r.Name = v
+	return r
+}
+
+// GetSize
+// 文件大小
+func (r *OfflineFileEventFile) GetSize() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Size
+}
+
+// SetSize
+// 文件大小
+func (r *OfflineFileEventFile) SetSize(v int64) *OfflineFileEventFile {
+	r.Size = v
+	return r
+}
+
+// GetUrl
+// 下载链接
+func (r *OfflineFileEventFile) GetUrl() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Url
+}
+
+// SetUrl
+// 下载链接
+func (r *OfflineFileEventFile) SetUrl(v string) *OfflineFileEventFile {
+	r.Url = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *ClientStatusEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *ClientStatusEvent) SetTime(v int64) *ClientStatusEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *ClientStatusEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *ClientStatusEvent) SetSelfId(v int64) *ClientStatusEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *ClientStatusEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *ClientStatusEvent) SetPostType(v EventPostType) *ClientStatusEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 通知类型 | 可能的值: client_status
+func (r *ClientStatusEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 通知类型 | 可能的值: client_status
+func (r *ClientStatusEvent) SetNoticeType(v EventNoticeType) *ClientStatusEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetClient
+// 客户端信息
+func (r *ClientStatusEvent) GetClient() *OnlineClient {
+	if r == nil {
+		var zero *OnlineClient
+		return zero
+	}
+	return r.Client
+}
+
+// SetClient
+// 客户端信息
+func (r *ClientStatusEvent) SetClient(v *OnlineClient) *ClientStatusEvent {
+	r.Client = v
+	return r
+}
+
+// GetOnline
+// 当前是否在线
+func (r *ClientStatusEvent) GetOnline() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	return r.Online
+}
+
+// SetOnline
+// 当前是否在线
+func (r *ClientStatusEvent) SetOnline(v bool) *ClientStatusEvent {
+	r.Online = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *EssenceEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *EssenceEvent) SetTime(v int64) *EssenceEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *EssenceEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *EssenceEvent) SetSelfId(v int64) *EssenceEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *EssenceEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *EssenceEvent) SetPostType(v EventPostType) *EssenceEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 通知类型 | 可能的值: essence
+func (r *EssenceEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 通知类型 | 可能的值: essence
+func (r *EssenceEvent) SetNoticeType(v EventNoticeType) *EssenceEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetSubType
+// 事件子类型，分别表示添加和移出精华消息 | 可能的值: add, delete
+func (r *EssenceEvent) GetSubType() EventEssenceSubType {
+	if r == nil {
+		var zero EventEssenceSubType
+		return zero
+	}
+	return r.SubType
+}
+
+// SetSubType
+// 事件子类型，分别表示添加和移出精华消息 | 可能的值: add, delete
+func (r *EssenceEvent) SetSubType(v EventEssenceSubType) *EssenceEvent {
+	r.SubType = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *EssenceEvent) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *EssenceEvent) SetGroupId(v int64) *EssenceEvent {
+	r.GroupId = v
+	return r
+}
+
+// GetSenderId
+// 消息发送者 QQ 号
+func (r *EssenceEvent) GetSenderId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SenderId
+}
+
+// SetSenderId
+// 消息发送者 QQ 号
+func (r *EssenceEvent) SetSenderId(v int64) *EssenceEvent {
+	r.SenderId = v
+	return r
+}
+
+// GetOperatorId
+// 操作者 QQ 号
+func (r *EssenceEvent) GetOperatorId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.OperatorId
+}
+
+// SetOperatorId
+// 操作者 QQ 号
+func (r *EssenceEvent) SetOperatorId(v int64) *EssenceEvent {
+	r.OperatorId = v
+	return r
+}
+
+// GetMessageId
+// 消息 ID
+func (r *EssenceEvent) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 消息 ID
+func (r *EssenceEvent) SetMessageId(v int64) *EssenceEvent {
+	r.MessageId = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *GroupMsgEmojiLikeEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *GroupMsgEmojiLikeEvent) SetTime(v int64) *GroupMsgEmojiLikeEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupMsgEmojiLikeEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *GroupMsgEmojiLikeEvent) SetSelfId(v int64) *GroupMsgEmojiLikeEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *GroupMsgEmojiLikeEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *GroupMsgEmojiLikeEvent) SetPostType(v EventPostType) *GroupMsgEmojiLikeEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 通知类型 | 可能的值: group_msg_emoji_like
+func (r *GroupMsgEmojiLikeEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 通知类型 | 可能的值: group_msg_emoji_like
+func (r *GroupMsgEmojiLikeEvent) SetNoticeType(v EventNoticeType) *GroupMsgEmojiLikeEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetGroupId
+// 群号
+func (r *GroupMsgEmojiLikeEvent) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号
+func (r *GroupMsgEmojiLikeEvent) SetGroupId(v int64) *GroupMsgEmojiLikeEvent {
+	r.GroupId = v
+	return r
+}
+
+// GetUserId
+// 回应者 QQ 号
+func (r *GroupMsgEmojiLikeEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 回应者 QQ 号
+func (r *GroupMsgEmojiLikeEvent) SetUserId(v int64) *GroupMsgEmojiLikeEvent {
+	r.UserId = v
+	return r
+}
+
+// GetMessageId
+// 被回应的消息 ID
+func (r *GroupMsgEmojiLikeEvent) GetMessageId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.MessageId
+}
+
+// SetMessageId
+// 被回应的消息 ID
+func (r *GroupMsgEmojiLikeEvent) SetMessageId(v int64) *GroupMsgEmojiLikeEvent {
+	r.MessageId = v
+	return r
+}
+
+// GetLikes
+// 表情回应列表
+func (r *GroupMsgEmojiLikeEvent) GetLikes() []*GroupMsgEmojiLike {
+	if r == nil {
+		var zero []*GroupMsgEmojiLike
+		return zero
+	}
+	return r.Likes
+}
+
+// SetLikes
+// 表情回应列表
+func (r *GroupMsgEmojiLikeEvent) SetLikes(v []*GroupMsgEmojiLike) *GroupMsgEmojiLikeEvent {
+	r.Likes = v
+	return r
+}
+
+// GetEmojiId
+// 表情 ID
+func (r *GroupMsgEmojiLike) GetEmojiId() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.EmojiId
+}
+
+// SetEmojiId
+// 表情 ID
+func (r *GroupMsgEmojiLike) SetEmojiId(v string) *GroupMsgEmojiLike {
+	r.EmojiId = v
+	return r
+}
+
+// GetCount
+// 回应数量
+func (r *GroupMsgEmojiLike) GetCount() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Count
+}
+
+// SetCount
+// 回应数量
+func (r *GroupMsgEmojiLike) SetCount(v int64) *GroupMsgEmojiLike {
+	r.Count = v
+	return r
+}
+
+// GetTime
+// 事件发生的时间戳
+func (r *InputStatusEvent) GetTime() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.Time
+}
+
+// SetTime
+// 事件发生的时间戳
+func (r *InputStatusEvent) SetTime(v int64) *InputStatusEvent {
+	r.Time = v
+	return r
+}
+
+// GetSelfId
+// 收到事件的机器人 QQ 号
+func (r *InputStatusEvent) GetSelfId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.SelfId
+}
+
+// SetSelfId
+// 收到事件的机器人 QQ 号
+func (r *InputStatusEvent) SetSelfId(v int64) *InputStatusEvent {
+	r.SelfId = v
+	return r
+}
+
+// GetPostType
+// 上报类型 | 可能的值: notice
+func (r *InputStatusEvent) GetPostType() EventPostType {
+	if r == nil {
+		var zero EventPostType
+		return zero
+	}
+	return r.PostType
+}
+
+// SetPostType
+// 上报类型 | 可能的值: notice
+func (r *InputStatusEvent) SetPostType(v EventPostType) *InputStatusEvent {
+	r.PostType = v
+	return r
+}
+
+// GetNoticeType
+// 消息类型 | 可能的值: notify
+func (r *InputStatusEvent) GetNoticeType() EventNoticeType {
+	if r == nil {
+		var zero EventNoticeType
+		return zero
+	}
+	return r.NoticeType
+}
+
+// SetNoticeType
+// 消息类型 | 可能的值: notify
+func (r *InputStatusEvent) SetNoticeType(v EventNoticeType) *InputStatusEvent {
+	r.NoticeType = v
+	return r
+}
+
+// GetSubType
+// 提示类型 | 可能的值: input_status
+func (r *InputStatusEvent) GetSubType() EventNoticeSubType {
+	if r == nil {
+		var zero EventNoticeSubType
+		return zero
+	}
+	return r.SubType
+}
+
+// SetSubType
+// 提示类型 | 可能的值: input_status
+func (r *InputStatusEvent) SetSubType(v EventNoticeSubType) *InputStatusEvent {
+	r.SubType = v
+	return r
+}
+
+// GetUserId
+// 对方 QQ 号
+func (r *InputStatusEvent) GetUserId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.UserId
+}
+
+// SetUserId
+// 对方 QQ 号
+func (r *InputStatusEvent) SetUserId(v int64) *InputStatusEvent {
+	r.UserId = v
+	return r
+}
+
+// GetGroupId
+// 群号，私聊时为 0
+func (r *InputStatusEvent) GetGroupId() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.GroupId
+}
+
+// SetGroupId
+// 群号，私聊时为 0
+func (r *InputStatusEvent) SetGroupId(v int64) *InputStatusEvent {
+	r.GroupId = v
+	return r
+}
+
+// GetStatusText
+// 状态文本，例如 `对方正在输入...`
+func (r *InputStatusEvent) GetStatusText() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.StatusText
+}
+
+// SetStatusText
+// 状态文本，例如 `对方正在输入...`
+func (r *InputStatusEvent) SetStatusText(v string) *InputStatusEvent {
+	r.StatusText = v
+	return r
+}
+
+// GetEventType
+// 状态类型，1 表示正在输入，0 表示停止输入
+func (r *InputStatusEvent) GetEventType() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	return r.EventType
+}
+
+// SetEventType
+// 状态类型，1 表示正在输入，0 表示停止输入
+func (r *InputStatusEvent) SetEventType(v int64) *InputStatusEvent {
+	r.EventType = v
+	return r
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *PrivateMessageSentEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *PrivateMessageSentEvent) SetOrigin(key string, value any) *PrivateMessageSentEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *PrivateMessageSentEvent) UnmarshalJSON(data []byte) error {
+	type alias PrivateMessageSentEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal PrivateMessageSentEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r PrivateMessageSentEvent) MarshalJSON() ([]byte, error) {
+	type alias PrivateMessageSentEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PrivateMessageSentEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMessageSentEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMessageSentEvent) SetOrigin(key string, value any) *GroupMessageSentEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMessageSentEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupMessageSentEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMessageSentEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMessageSentEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupMessageSentEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMessageSentEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupCardEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupCardEvent) SetOrigin(key string, value any) *GroupCardEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupCardEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupCardEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupCardEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupCardEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupCardEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupCardEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OfflineFileEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OfflineFileEvent) SetOrigin(key string, value any) *OfflineFileEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OfflineFileEvent) UnmarshalJSON(data []byte) error {
+	type alias OfflineFileEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OfflineFileEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OfflineFileEvent) MarshalJSON() ([]byte, error) {
+	type alias OfflineFileEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OfflineFileEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *OfflineFileEventFile) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *OfflineFileEventFile) SetOrigin(key string, value any) *OfflineFileEventFile {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *OfflineFileEventFile) UnmarshalJSON(data []byte) error {
+	type alias OfflineFileEventFile
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal OfflineFileEventFile: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r OfflineFileEventFile) MarshalJSON() ([]byte, error) {
+	type alias OfflineFileEventFile
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OfflineFileEventFile: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *ClientStatusEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *ClientStatusEvent) SetOrigin(key string, value any) *ClientStatusEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *ClientStatusEvent) UnmarshalJSON(data []byte) error {
+	type alias ClientStatusEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal ClientStatusEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r ClientStatusEvent) MarshalJSON() ([]byte, error) {
+	type alias ClientStatusEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ClientStatusEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *EssenceEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *EssenceEvent) SetOrigin(key string, value any) *EssenceEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *EssenceEvent) UnmarshalJSON(data []byte) error {
+	type alias EssenceEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal EssenceEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r EssenceEvent) MarshalJSON() ([]byte, error) {
+	type alias EssenceEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal EssenceEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMsgEmojiLikeEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMsgEmojiLikeEvent) SetOrigin(key string, value any) *GroupMsgEmojiLikeEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMsgEmojiLikeEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupMsgEmojiLikeEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMsgEmojiLikeEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMsgEmojiLikeEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupMsgEmojiLikeEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMsgEmojiLikeEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMsgEmojiLike) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMsgEmojiLike) SetOrigin(key string, value any) *GroupMsgEmojiLike {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMsgEmojiLike) UnmarshalJSON(data []byte) error {
+	type alias GroupMsgEmojiLike
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMsgEmojiLike: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMsgEmojiLike) MarshalJSON() ([]byte, error) {
+	type alias GroupMsgEmojiLike
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMsgEmojiLike: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *InputStatusEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *InputStatusEvent) SetOrigin(key string, value any) *InputStatusEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *InputStatusEvent) UnmarshalJSON(data []byte) error {
+	type alias InputStatusEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal InputStatusEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r InputStatusEvent) MarshalJSON() ([]byte, error) {
+	type alias InputStatusEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal InputStatusEvent: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,260 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// EventConstructor 创建一个空的事件实例，用于反序列化时填充.
+type EventConstructor func() Event
+
+// eventPathSeparator 事件路径分隔符，与 EventDispatcher 的注册键格式一致.
+const eventPathSeparator = "/"
+
+// maxEventPathDepth 事件路径的最大层数: post_type / 具体类型 / sub_type.
+const maxEventPathDepth = 3
+
+// eventTreeNode 事件类型树的节点
+// 第一层按 post_type 分类，第二层按具体类型字段（如 message_type）分类，第三层按 sub_type 分类.
+type eventTreeNode struct {
+	constructor EventConstructor
+	children    *util.RadixTreeStrKey[*eventTreeNode]
+}
+
+type eventTypeRegistry struct {
+	mu   sync.RWMutex
+	root *eventTreeNode
+}
+
+//nolint:gochecknoglobals // 事件类型注册表需要在包级别共享
+var eventRegistry = newBuiltinEventRegistry()
+
+// eventTypeFields post_type 对应的具体类型字段名，未列出的 post_type 使用 "<post_type>_type".
+//
+//nolint:gochecknoglobals // 只读映射表
+var eventTypeFields = map[EventPostType]string{
+	EventPostTypeMessage:   "message_type",
+	EventPostTypeNotice:    "notice_type",
+	EventPostTypeRequest:   "request_type",
+	EventPostTypeMetaEvent: "meta_event_type",
+	// 机器人自身发送的消息与消息事件结构相同
+	EventPostTypeMessageSent: "message_type",
+}
+
+func newBuiltinEventRegistry() *eventTypeRegistry {
+	registry := &eventTypeRegistry{root: &eventTreeNode{}}
+
+	builtin := map[string]EventConstructor{
+		"message/private":          func() Event { return &PrivateMessageEvent{} },
+		"message/group":            func() Event { return &GroupMessageEvent{} },
+		"notice/group_upload":      func() Event { return &GroupFileUploadEvent{} },
+		"notice/group_admin":       func() Event { return &GroupAdminChangeEvent{} },
+		"notice/group_decrease":    func() Event { return &GroupMemberDecreaseEvent{} },
+		"notice/group_increase":    func() Event { return &GroupMemberIncreaseEvent{} },
+		"notice/group_ban":         func() Event { return &GroupBanEvent{} },
+		"notice/friend_add":        func() Event { return &FriendAddEvent{} },
+		"notice/group_recall":      func() Event { return &GroupRecallEvent{} },
+		"notice/friend_recall":     func() Event { return &FriendRecallEvent{} },
+		"notice/notify/poke":       func() Event { return &GroupPokeEvent{} },
+		"notice/notify/lucky_king": func() Event { return &GroupLuckyKingEvent{} },
+		"notice/notify/honor":      func() Event { return &GroupHonorChangeEvent{} },
+		"request/friend":           func() Event { return &FriendRequestEvent{} },
+		"request/group":            func() Event { return &GroupRequestEvent{} },
+		"meta_event/lifecycle":     func() Event { return &LifecycleEvent{} },
+		"meta_event/heartbeat":     func() Event { return &HeartbeatEvent{} },
+		// go-cqhttp / NapCat 等实现扩展的事件
+		"message_sent/private":        func() Event { return &PrivateMessageSentEvent{} },
+		"message_sent/group":          func() Event { return &GroupMessageSentEvent{} },
+		"notice/group_card":           func() Event { return &GroupCardEvent{} },
+		"notice/offline_file":         func() Event { return &OfflineFileEvent{} },
+		"notice/client_status":        func() Event { return &ClientStatusEvent{} },
+		"notice/essence":              func() Event { return &EssenceEvent{} },
+		"notice/group_msg_emoji_like": func() Event { return &GroupMsgEmojiLikeEvent{} },
+		"notice/notify/input_status":  func() Event { return &InputStatusEvent{} },
+	}
+
+	for path, constructor := range builtin {
+		registry.set(strings.Split(path, eventPathSeparator), constructor)
+	}
+
+	return registry
+}
+
+// RegisterEventType 注册事件类型及其构造函数
+// path 格式与 EventDispatcher 的注册键一致: "post_type"、"post_type/type" 或 "post_type/type/sub_type"，
+// 例如 "notice/notify/profile_like"。解析时优先使用最具体的路径，
+// 因此注册 "notice/group_name" 之后，所有 sub_type 的 group_name 通知都会使用该构造函数.
+// 重复注册同一路径会覆盖之前的构造函数，可用于替换内置实现；constructor 为 nil 时注销该路径.
+func RegisterEventType(path string, constructor EventConstructor) error {
+	keys, err := splitEventPath(path)
+	if err != nil {
+		return err
+	}
+
+	eventRegistry.mu.Lock()
+	defer eventRegistry.mu.Unlock()
+
+	eventRegistry.set(keys, constructor)
+
+	return nil
+}
+
+// IsEventTypeRegistered 判断事件路径是否已注册构造函数.
+func IsEventTypeRegistered(path string) bool {
+	keys, err := splitEventPath(path)
+	if err != nil {
+		return false
+	}
+
+	eventRegistry.mu.RLock()
+	defer eventRegistry.mu.RUnlock()
+
+	node := eventRegistry.root
+
+	for _, key := range keys {
+		if node.children == nil {
+			return false
+		}
+
+		child, ok := node.children.Get(key)
+		if !ok {
+			return false
+		}
+
+		node = child
+	}
+
+	return node.constructor != nil
+}
+
+// ParseEvent 将上报的事件 JSON 解析为具体的事件类型.
+// 根据 post_type、具体类型字段与 sub_type 在事件类型树中查找最具体的构造函数，
+// 未注册的事件类型返回 *UnknownEvent，仅当 JSON 无效或缺少 post_type 时返回错误.
+func ParseEvent(data []byte) (Event, error) {
+	var header map[string]any
+
+	err := json.Unmarshal(data, &header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event json: %w", err)
+	}
+
+	postType, _ := header["post_type"].(string)
+	if postType == "" {
+		return nil, ErrMissingOrInvalidPostType
+	}
+
+	typeValue, _ := header[eventTypeField(EventPostType(postType))].(string)
+	subType, _ := header["sub_type"].(string)
+
+	eventRegistry.mu.RLock()
+	constructor, err := eventRegistry.find([]string{postType, typeValue, subType})
+	eventRegistry.mu.RUnlock()
+
+	if err != nil {
+		constructor = func() Event { return &UnknownEvent{} }
+	}
+
+	event := constructor()
+
+	err = json.Unmarshal(data, event)
+	if err != nil {
+		return nil, fmt.Errorf("parse event failed: %w", err)
+	}
+
+	return event, nil
+}
+
+// eventTypeField 返回 post_type 对应的具体类型字段名.
+func eventTypeField(postType EventPostType) string {
+	if field, ok := eventTypeFields[postType]; ok {
+		return field
+	}
+
+	return string(postType) + "_type"
+}
+
+func splitEventPath(path string) ([]string, error) {
+	keys := strings.Split(path, eventPathSeparator)
+	if len(keys) > maxEventPathDepth {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidEventPath, path)
+	}
+
+	for _, key := range keys {
+		if key == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidEventPath, path)
+		}
+	}
+
+	return keys, nil
+}
+
+// set 设置路径对应的构造函数，调用方需持有写锁.
+func (r *eventTypeRegistry) set(keys []string, constructor EventConstructor) {
+	node := r.root
+
+	for _, key := range keys {
+		if node.children == nil {
+			node.children = util.NewRadixTree[string, *eventTreeNode]()
+		}
+
+		child, ok := node.children.Get(key)
+		if !ok {
+			child = &eventTreeNode{}
+			node.children.Insert(key, child)
+		}
+
+		node = child
+	}
+
+	node.constructor = constructor
+}
+
+// find 按层级查找最具体的构造函数，调用方需持有读锁.
+func (r *eventTypeRegistry) find(keys []string) (EventConstructor, error) {
+	var constructor EventConstructor
+
+	node := r.root
+
+	for i, key := range keys {
+		if node.children == nil || node.children.Len() == 0 {
+			break
+		}
+
+		if key == "" {
+			if constructor != nil {
+				break
+			}
+
+			return nil, fmt.Errorf("%w at path: %s", ErrMissingTypeField, strings.Join(keys[:i], eventPathSeparator))
+		}
+
+		child, ok := node.children.Get(key)
+		if !ok {
+			if constructor != nil {
+				break
+			}
+
+			if i == 0 {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownPostType, key)
+			}
+
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, strings.Join(keys[:i+1], eventPathSeparator))
+		}
+
+		node = child
+
+		if child.constructor != nil {
+			constructor = child.constructor
+		}
+	}
+
+	if constructor == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, strings.Join(keys, eventPathSeparator))
+	}
+
+	return constructor, nil
+}
@@ -0,0 +1,158 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventBuiltinTypes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		payload string
+		want    Event
+	}{
+		{
+			name:    "private_message",
+			payload: `{"post_type":"message","message_type":"private","sub_type":"friend","user_id":1}`,
+			want:    &PrivateMessageEvent{},
+		},
+		{
+			name:    "notify_poke",
+			payload: `{"post_type":"notice","notice_type":"notify","sub_type":"poke","target_id":2}`,
+			want:    &GroupPokeEvent{},
+		},
+		{
+			name:    "heartbeat",
+			payload: `{"post_type":"meta_event","meta_event_type":"heartbeat","interval":5000}`,
+			want:    &HeartbeatEvent{},
+		},
+		{
+			name:    "message_sent_private",
+			payload: `{"post_type":"message_sent","message_type":"private","user_id":1,"target_id":2,"message":"hi"}`,
+			want:    &PrivateMessageSentEvent{},
+		},
+		{
+			name:    "message_sent_group",
+			payload: `{"post_type":"message_sent","message_type":"group","group_id":1,"message":"hi"}`,
+			want:    &GroupMessageSentEvent{},
+		},
+		{
+			name:    "group_card",
+			payload: `{"post_type":"notice","notice_type":"group_card","card_new":"a","card_old":"b"}`,
+			want:    &GroupCardEvent{},
+		},
+		{
+			name:    "offline_file",
+			payload: `{"post_type":"notice","notice_type":"offline_file","file":{"name":"a","size":1,"url":"u"}}`,
+			want:    &OfflineFileEvent{},
+		},
+		{
+			name:    "client_status",
+			payload: `{"post_type":"notice","notice_type":"client_status","client":{"app_id":1},"online":true}`,
+			want:    &ClientStatusEvent{},
+		},
+		{
+			name:    "essence",
+			payload: `{"post_type":"notice","notice_type":"essence","sub_type":"add","message_id":1}`,
+			want:    &EssenceEvent{},
+		},
+		{
+			name:    "group_msg_emoji_like",
+			payload: `{"post_type":"notice","notice_type":"group_msg_emoji_like","likes":[{"emoji_id":"76","count":1}]}`,
+			want:    &GroupMsgEmojiLikeEvent{},
+		},
+		{
+			name:    "input_status",
+			payload: `{"post_type":"notice","notice_type":"notify","sub_type":"input_status","event_type":1}`,
+			want:    &InputStatusEvent{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := ParseEvent([]byte(tc.payload))
+			require.NoError(t, err)
+			require.IsType(t, tc.want, event)
+
+			got, err := json.Marshal(event)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.payload, string(got))
+		})
+	}
+}
+
+func TestParseEventErrors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{name: "missing_post_type", payload: `{"time":1}`, wantErr: ErrMissingOrInvalidPostType},
+		{name: "invalid_post_type", payload: `{"post_type":1}`, wantErr: ErrMissingOrInvalidPostType},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseEvent([]byte(tc.payload))
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	_, err := ParseEvent([]byte(`{`))
+	require.Error(t, err)
+}
+
+func TestRegisterEventType(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, IsEventTypeRegistered("registry_test/a"))
+
+	err := RegisterEventType("registry_test/a", func() Event { return &FriendAddEvent{} })
+	require.NoError(t, err)
+	require.True(t, IsEventTypeRegistered("registry_test/a"))
+	require.False(t, IsEventTypeRegistered("registry_test"))
+
+	// 自定义 post_type 使用 "<post_type>_type" 字段作为第二层
+	event, err := ParseEvent([]byte(`{"post_type":"registry_test","registry_test_type":"a","user_id":1}`))
+	require.NoError(t, err)
+	friendAdd, ok := event.(*FriendAddEvent)
+	require.True(t, ok)
+	require.Equal(t, int64(1), friendAdd.UserId)
+
+	// 未注册的 sub_type 回退到上一层的构造函数，已注册的 sub_type 优先
+	err = RegisterEventType("registry_test/a/b", func() Event { return &GroupRecallEvent{} })
+	require.NoError(t, err)
+
+	event, err = ParseEvent([]byte(`{"post_type":"registry_test","registry_test_type":"a","sub_type":"c"}`))
+	require.NoError(t, err)
+	require.IsType(t, &FriendAddEvent{}, event)
+
+	event, err = ParseEvent([]byte(`{"post_type":"registry_test","registry_test_type":"a","sub_type":"b"}`))
+	require.NoError(t, err)
+	require.IsType(t, &GroupRecallEvent{}, event)
+
+	// 注销
+	err = RegisterEventType("registry_test/a", nil)
+	require.NoError(t, err)
+	require.False(t, IsEventTypeRegistered("registry_test/a"))
+
+	event, err = ParseEvent([]byte(`{"post_type":"registry_test","registry_test_type":"a","sub_type":"c"}`))
+	require.NoError(t, err)
+	require.IsType(t, &UnknownEvent{}, event)
+
+	for _, path := range []string{"", "a//b", "a/b/c/d"} {
+		err = RegisterEventType(path, func() Event { return &FriendAddEvent{} })
+		require.ErrorIs(t, err, ErrInvalidEventPath)
+		require.False(t, IsEventTypeRegistered(path))
+	}
+}
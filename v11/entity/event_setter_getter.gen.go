@@ -2,6 +2,12 @@
 
 package entity
 
+import (
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
 // GetTime
 // 事件发生的时间戳
 func (r *PrivateMessageEvent) GetTime() int64 {
@@ -2555,3 +2561,803 @@ func (r *HeartbeatEvent) SetInterval(v int64) *HeartbeatEvent {
 	r.Interval = v
 	return r
 }
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *PrivateMessageEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *PrivateMessageEvent) SetOrigin(key string, value any) *PrivateMessageEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *PrivateMessageEvent) UnmarshalJSON(data []byte) error {
+	type alias PrivateMessageEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal PrivateMessageEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r PrivateMessageEvent) MarshalJSON() ([]byte, error) {
+	type alias PrivateMessageEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PrivateMessageEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *PrivateMessageEventSender) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *PrivateMessageEventSender) SetOrigin(key string, value any) *PrivateMessageEventSender {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *PrivateMessageEventSender) UnmarshalJSON(data []byte) error {
+	type alias PrivateMessageEventSender
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal PrivateMessageEventSender: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r PrivateMessageEventSender) MarshalJSON() ([]byte, error) {
+	type alias PrivateMessageEventSender
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal PrivateMessageEventSender: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMessageEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMessageEvent) SetOrigin(key string, value any) *GroupMessageEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMessageEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupMessageEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMessageEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMessageEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupMessageEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMessageEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMessageEventSender) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMessageEventSender) SetOrigin(key string, value any) *GroupMessageEventSender {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMessageEventSender) UnmarshalJSON(data []byte) error {
+	type alias GroupMessageEventSender
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMessageEventSender: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMessageEventSender) MarshalJSON() ([]byte, error) {
+	type alias GroupMessageEventSender
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMessageEventSender: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupFileUploadEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupFileUploadEvent) SetOrigin(key string, value any) *GroupFileUploadEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupFileUploadEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupFileUploadEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupFileUploadEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupFileUploadEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupFileUploadEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupFileUploadEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupFileUploadEventFile) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupFileUploadEventFile) SetOrigin(key string, value any) *GroupFileUploadEventFile {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupFileUploadEventFile) UnmarshalJSON(data []byte) error {
+	type alias GroupFileUploadEventFile
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupFileUploadEventFile: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupFileUploadEventFile) MarshalJSON() ([]byte, error) {
+	type alias GroupFileUploadEventFile
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupFileUploadEventFile: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupAdminChangeEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupAdminChangeEvent) SetOrigin(key string, value any) *GroupAdminChangeEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupAdminChangeEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupAdminChangeEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupAdminChangeEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupAdminChangeEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupAdminChangeEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupAdminChangeEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMemberDecreaseEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMemberDecreaseEvent) SetOrigin(key string, value any) *GroupMemberDecreaseEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMemberDecreaseEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupMemberDecreaseEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMemberDecreaseEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMemberDecreaseEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupMemberDecreaseEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMemberDecreaseEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupMemberIncreaseEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupMemberIncreaseEvent) SetOrigin(key string, value any) *GroupMemberIncreaseEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupMemberIncreaseEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupMemberIncreaseEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupMemberIncreaseEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupMemberIncreaseEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupMemberIncreaseEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupMemberIncreaseEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupBanEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupBanEvent) SetOrigin(key string, value any) *GroupBanEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupBanEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupBanEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupBanEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupBanEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupBanEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupBanEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *FriendAddEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *FriendAddEvent) SetOrigin(key string, value any) *FriendAddEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *FriendAddEvent) UnmarshalJSON(data []byte) error {
+	type alias FriendAddEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal FriendAddEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r FriendAddEvent) MarshalJSON() ([]byte, error) {
+	type alias FriendAddEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal FriendAddEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupRecallEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupRecallEvent) SetOrigin(key string, value any) *GroupRecallEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupRecallEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupRecallEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupRecallEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupRecallEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupRecallEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupRecallEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *FriendRecallEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *FriendRecallEvent) SetOrigin(key string, value any) *FriendRecallEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *FriendRecallEvent) UnmarshalJSON(data []byte) error {
+	type alias FriendRecallEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal FriendRecallEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r FriendRecallEvent) MarshalJSON() ([]byte, error) {
+	type alias FriendRecallEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal FriendRecallEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupPokeEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupPokeEvent) SetOrigin(key string, value any) *GroupPokeEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupPokeEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupPokeEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupPokeEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupPokeEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupPokeEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupPokeEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupLuckyKingEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupLuckyKingEvent) SetOrigin(key string, value any) *GroupLuckyKingEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupLuckyKingEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupLuckyKingEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupLuckyKingEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupLuckyKingEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupLuckyKingEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupLuckyKingEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupHonorChangeEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupHonorChangeEvent) SetOrigin(key string, value any) *GroupHonorChangeEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupHonorChangeEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupHonorChangeEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupHonorChangeEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupHonorChangeEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupHonorChangeEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupHonorChangeEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *FriendRequestEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *FriendRequestEvent) SetOrigin(key string, value any) *FriendRequestEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *FriendRequestEvent) UnmarshalJSON(data []byte) error {
+	type alias FriendRequestEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal FriendRequestEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r FriendRequestEvent) MarshalJSON() ([]byte, error) {
+	type alias FriendRequestEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal FriendRequestEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *GroupRequestEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *GroupRequestEvent) SetOrigin(key string, value any) *GroupRequestEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *GroupRequestEvent) UnmarshalJSON(data []byte) error {
+	type alias GroupRequestEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal GroupRequestEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r GroupRequestEvent) MarshalJSON() ([]byte, error) {
+	type alias GroupRequestEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupRequestEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *LifecycleEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *LifecycleEvent) SetOrigin(key string, value any) *LifecycleEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *LifecycleEvent) UnmarshalJSON(data []byte) error {
+	type alias LifecycleEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal LifecycleEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r LifecycleEvent) MarshalJSON() ([]byte, error) {
+	type alias LifecycleEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal LifecycleEvent: %w", err)
+	}
+	return data, nil
+}
+
+// GetOrigin 获取指定 key 的原始字段值.
+func (r *HeartbeatEvent) GetOrigin(key string) any {
+	if r == nil || r.origin == nil {
+		return nil
+	}
+	return r.origin[key]
+}
+
+// SetOrigin 设置指定 key 的原始字段值.
+func (r *HeartbeatEvent) SetOrigin(key string, value any) *HeartbeatEvent {
+	if r == nil {
+		return r
+	}
+	if r.origin == nil {
+		r.origin = make(map[string]any)
+	}
+	r.origin[key] = value
+	return r
+}
+
+// UnmarshalJSON 自定义反序列化，同时捕获所有原始字段数据.
+func (r *HeartbeatEvent) UnmarshalJSON(data []byte) error {
+	type alias HeartbeatEvent
+	err := util.JsonUnmarshalWithOrigin(data, (*alias)(r), &r.origin)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal HeartbeatEvent: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON 自定义序列化，保留反序列化时捕获的未声明字段.
+func (r HeartbeatEvent) MarshalJSON() ([]byte, error) {
+	type alias HeartbeatEvent
+	data, err := util.JsonMarshalWithOrigin(alias(r), r.origin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal HeartbeatEvent: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventOriginRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	jsonData := `{
+		"time": 1700000000,
+		"self_id": 1,
+		"post_type": "message",
+		"message_type": "group",
+		"sub_type": "normal",
+		"message_id": 2,
+		"group_id": 3,
+		"user_id": 4,
+		"message": [{"type": "text", "data": {"text": "hi"}}, {"type": "mface", "data": {"emoji_id": "x"}}],
+		"raw_message": "hi",
+		"font": 0,
+		"sender": {"user_id": 4, "nickname": "n", "role": "member"},
+		"message_seq": 5,
+		"real_id": 6,
+		"group_name": "g"
+	}`
+
+	var event *GroupMessageEvent
+
+	err := json.Unmarshal([]byte(jsonData), &event)
+	require.NoError(t, err)
+
+	// 已知字段仍通过 encoding/json 解码，嵌套类型的自定义反序列化生效
+	require.Equal(t, int64(3), event.GroupId)
+	require.Equal(t, "hi", event.Message.PlainText())
+	require.Equal(t, "n", event.Sender.Nickname)
+
+	// 未声明字段通过 GetOrigin 读取
+	require.Equal(t, json.Number("5"), event.GetOrigin("message_seq"))
+	require.Equal(t, "g", event.GetOrigin("group_name"))
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.JSONEq(t, jsonData, string(data))
+}
+
+func TestEventOriginMarshalKnownFieldWins(t *testing.T) {
+	t.Parallel()
+
+	var event *FriendAddEvent
+
+	err := json.Unmarshal(
+		[]byte(`{"time":1,"self_id":2,"post_type":"notice","notice_type":"friend_add","user_id":3,"extra":true}`),
+		&event,
+	)
+	require.NoError(t, err)
+
+	event.SetUserId(30).SetOrigin("extra", false)
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"time":1,"self_id":2,"post_type":"notice","notice_type":"friend_add","user_id":30,"extra":false}`,
+		string(data),
+	)
+
+	// 未经反序列化的事件按声明字段序列化
+	data, err = json.Marshal(&FriendAddEvent{UserId: 3})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"time":0,"self_id":0,"post_type":"","notice_type":"","user_id":3}`, string(data))
+}
+
+func TestEventOriginLargeIntegerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	jsonData := `{"time":1,"self_id":2,"post_type":"notice","notice_type":"friend_add","user_id":3,` +
+		`"guild_id":144115218676755431,"channel_id":9007199254740993}`
+
+	var event *FriendAddEvent
+
+	err := json.Unmarshal([]byte(jsonData), &event)
+	require.NoError(t, err)
+	require.Equal(t, json.Number("144115218676755431"), event.GetOrigin("guild_id"))
+
+	// JSONEq 按 float64 比较数字，无法发现精度丢失，因此直接比较序列化结果
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"guild_id":144115218676755431`)
+	require.Contains(t, string(data), `"channel_id":9007199254740993`)
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// UnknownEvent 未注册类型的事件
+// 实现端上报了 SDK 尚未支持的 post_type、具体类型或 sub_type 时，ParseEvent 返回该类型而不是报错，
+// 由事件处理器自行决定如何处理，也可以通过 RegisterEventType 注册具体类型.
+type UnknownEvent struct {
+	// 事件发生的时间戳
+	Time int64 `json:"time"`
+	// 收到事件的机器人 QQ 号
+	SelfId int64 `json:"self_id"`
+	// 上报类型
+	PostType EventPostType `json:"post_type"`
+	// 具体类型字段的值，如 message_type、notice_type，字段名由 post_type 决定
+	DetailType string `json:"-"`
+	// 事件子类型
+	SubType string `json:"sub_type,omitempty"`
+	// 原始事件 JSON
+	Raw json.RawMessage `json:"-"`
+}
+
+// GetTime 事件发生的时间戳.
+func (e *UnknownEvent) GetTime() int64 {
+	if e == nil {
+		return 0
+	}
+
+	return e.Time
+}
+
+// GetSelfId 收到事件的机器人 QQ 号.
+func (e *UnknownEvent) GetSelfId() int64 {
+	if e == nil {
+		return 0
+	}
+
+	return e.SelfId
+}
+
+// GetPostType 上报类型.
+func (e *UnknownEvent) GetPostType() EventPostType {
+	if e == nil {
+		return ""
+	}
+
+	return e.PostType
+}
+
+// GetDetailType 具体类型字段的值.
+func (e *UnknownEvent) GetDetailType() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.DetailType
+}
+
+// GetSubType 事件子类型.
+func (e *UnknownEvent) GetSubType() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.SubType
+}
+
+// GetRaw 原始事件 JSON.
+func (e *UnknownEvent) GetRaw() json.RawMessage {
+	if e == nil {
+		return nil
+	}
+
+	return e.Raw
+}
+
+// Decode 将原始事件 JSON 反序列化到 v，用于处理器按需解析扩展字段.
+func (e *UnknownEvent) Decode(v any) error {
+	err := json.Unmarshal(e.GetRaw(), v)
+	if err != nil {
+		return fmt.Errorf("decode unknown event: %w", err)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON 保存原始 JSON 并解析通用字段.
+func (e *UnknownEvent) UnmarshalJSON(data []byte) error {
+	var header map[string]any
+
+	err := json.Unmarshal(data, &header)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal UnknownEvent: %w", err)
+	}
+
+	type alias UnknownEvent
+
+	err = json.Unmarshal(data, (*alias)(e))
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal UnknownEvent: %w", err)
+	}
+
+	e.DetailType, _ = header[eventTypeField(e.PostType)].(string)
+	e.Raw = bytes.Clone(data)
+
+	return nil
+}
+
+// MarshalJSON 原样输出原始 JSON，未经反序列化的事件输出通用字段.
+func (e *UnknownEvent) MarshalJSON() ([]byte, error) {
+	if len(e.Raw) > 0 {
+		return e.Raw, nil
+	}
+
+	fields := map[string]any{
+		"time":      e.Time,
+		"self_id":   e.SelfId,
+		"post_type": e.PostType,
+	}
+
+	if e.DetailType != "" {
+		fields[eventTypeField(e.PostType)] = e.DetailType
+	}
+
+	if e.SubType != "" {
+		fields["sub_type"] = e.SubType
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal UnknownEvent: %w", err)
+	}
+
+	return data, nil
+}
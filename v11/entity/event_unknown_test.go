@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEventUnknownFallback(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		payload        string
+		wantDetailType string
+		wantSubType    string
+	}{
+		{
+			name:    "unknown_post_type",
+			payload: `{"time":1,"self_id":2,"post_type":"self_message","self_message_type":"self","x":1}`,
+			// 未知 post_type 使用 "<post_type>_type" 字段
+			wantDetailType: "self",
+		},
+		{
+			name:           "unknown_notice_type",
+			payload:        `{"time":1,"self_id":2,"post_type":"notice","notice_type":"group_name","name_new":"a"}`,
+			wantDetailType: "group_name",
+		},
+		{
+			name:           "unknown_sub_type",
+			payload:        `{"time":1,"self_id":2,"post_type":"notice","notice_type":"notify","sub_type":"profile_like"}`,
+			wantDetailType: "notify",
+			wantSubType:    "profile_like",
+		},
+		{
+			name:    "missing_type_field",
+			payload: `{"time":1,"self_id":2,"post_type":"message"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := ParseEvent([]byte(tc.payload))
+			require.NoError(t, err)
+
+			unknown, ok := event.(*UnknownEvent)
+			require.True(t, ok)
+			require.Equal(t, int64(1), unknown.GetTime())
+			require.Equal(t, int64(2), unknown.GetSelfId())
+			require.Equal(t, tc.wantDetailType, unknown.GetDetailType())
+			require.Equal(t, tc.wantSubType, unknown.GetSubType())
+			require.JSONEq(t, tc.payload, string(unknown.GetRaw()))
+
+			data, err := json.Marshal(unknown)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.payload, string(data))
+		})
+	}
+}
+
+func TestUnknownEventDecode(t *testing.T) {
+	t.Parallel()
+
+	event, err := ParseEvent([]byte(`{"post_type":"notice","notice_type":"group_name","name_new":"a"}`))
+	require.NoError(t, err)
+
+	unknown, ok := event.(*UnknownEvent)
+	require.True(t, ok)
+
+	var payload struct {
+		NameNew string `json:"name_new"`
+	}
+
+	require.NoError(t, unknown.Decode(&payload))
+	require.Equal(t, "a", payload.NameNew)
+
+	var nilEvent *UnknownEvent
+	require.Empty(t, nilEvent.GetPostType())
+	require.Error(t, nilEvent.Decode(&payload))
+}
+
+func TestUnknownEventMarshalWithoutRaw(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(&UnknownEvent{
+		Time: 1, SelfId: 2, PostType: EventPostTypeNotice, DetailType: "group_name", SubType: "x",
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"time":1,"self_id":2,"post_type":"notice","notice_type":"group_name","sub_type":"x"}`, string(data))
+}
@@ -36,12 +36,14 @@ func (m *MessageValue) UnmarshalJSON(data []byte) error {
 	var arr []*Segment
 	if bytes.HasPrefix(data, []byte{'['}) {
 		err := json.Unmarshal(data, &arr)
-		if err == nil {
-			m.Type = MessageValueTypeArray
-			m.ArrayValue = arr
-
-			return nil
+		if err != nil {
+			return fmt.Errorf("unmarshal array value: %w", err)
 		}
+
+		m.Type = MessageValueTypeArray
+		m.ArrayValue = arr
+
+		return nil
 	}
 
 	return nil
@@ -70,3 +72,37 @@ func (m *MessageValue) MarshalJSON() ([]byte, error) {
 
 	return []byte{'n', 'u', 'l', 'l'}, nil
 }
+
+// Segments 返回消息段数组形式的消息内容
+// 字符串形式的消息会按 CQ 码解析.
+func (m *MessageValue) Segments() ([]*Segment, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	switch m.Type {
+	case MessageValueTypeArray:
+		return m.ArrayValue, nil
+	case MessageValueTypeString:
+		return ParseCQString(m.StringValue)
+	default:
+		return nil, nil
+	}
+}
+
+// CQString 返回 CQ 码字符串形式的消息内容
+// 数组形式的消息会序列化为 CQ 码.
+func (m *MessageValue) CQString() (string, error) {
+	if m == nil {
+		return "", nil
+	}
+
+	switch m.Type {
+	case MessageValueTypeString:
+		return m.StringValue, nil
+	case MessageValueTypeArray:
+		return FormatCQString(m.ArrayValue)
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,202 @@
+package entity
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// MessageBuilder 消息链构造器
+// 例如: NewMessage().Reply(id).At(uid).Text("hi").Image(file).Build().
+type MessageBuilder struct {
+	segments []*Segment
+}
+
+// NewMessage 创建消息链构造器.
+func NewMessage() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// Append 追加任意消息段数据，可用于设置快捷方法未覆盖的可选字段或自定义消息段，
+// nil 与值为 nil 的指针（例如 (*PokeSegmentData)(nil)）会被忽略.
+func (b *MessageBuilder) Append(data ...SegmentData) *MessageBuilder {
+	for _, d := range data {
+		if !isNilSegmentData(d) {
+			b.segments = append(b.segments, NewSegment(d))
+		}
+	}
+
+	return b
+}
+
+// Text 纯文本.
+func (b *MessageBuilder) Text(text string) *MessageBuilder {
+	return b.Append(&TextSegmentData{Text: text})
+}
+
+// Face QQ 表情.
+func (b *MessageBuilder) Face(id int64) *MessageBuilder {
+	return b.Append(&FaceSegmentData{Id: strconv.FormatInt(id, 10)})
+}
+
+// Image 图片，file 可以是文件名、绝对路径、网络 URL 或 base64.
+func (b *MessageBuilder) Image(file string) *MessageBuilder {
+	return b.Append(&ImageSegmentData{File: file})
+}
+
+// FlashImage 闪照.
+func (b *MessageBuilder) FlashImage(file string) *MessageBuilder {
+	return b.Append(&ImageSegmentData{File: file, Type: ImageSegmentDataTypeFlash})
+}
+
+// Record 语音.
+func (b *MessageBuilder) Record(file string) *MessageBuilder {
+	return b.Append(&RecordSegmentData{File: file})
+}
+
+// Video 短视频.
+func (b *MessageBuilder) Video(file string) *MessageBuilder {
+	return b.Append(&VideoSegmentData{File: file})
+}
+
+// At @某人.
+func (b *MessageBuilder) At(userId int64) *MessageBuilder {
+	return b.Append(&AtSegmentData{QQ: strconv.FormatInt(userId, 10)})
+}
+
+// AtAll @全体成员.
+func (b *MessageBuilder) AtAll() *MessageBuilder {
+	return b.Append(&AtSegmentData{QQ: "all"})
+}
+
+// Rps 猜拳魔法表情.
+func (b *MessageBuilder) Rps() *MessageBuilder {
+	return b.Append(&RpsSegmentData{})
+}
+
+// Dice 掷骰子魔法表情.
+func (b *MessageBuilder) Dice() *MessageBuilder {
+	return b.Append(&DiceSegmentData{})
+}
+
+// Shake 窗口抖动（戳一戳）.
+func (b *MessageBuilder) Shake() *MessageBuilder {
+	return b.Append(&ShakeSegmentData{})
+}
+
+// Poke 戳一戳，可直接传入 PokeSegmentDataPoke() 等预设，poke 为 nil 时不追加.
+func (b *MessageBuilder) Poke(poke *PokeSegmentData) *MessageBuilder {
+	return b.Append(poke)
+}
+
+// Anonymous 匿名发消息，ignore 表示无法匿名时是否继续发送.
+func (b *MessageBuilder) Anonymous(ignore bool) *MessageBuilder {
+	flag := IgnoreFlagNo
+	if ignore {
+		flag = IgnoreFlagYes
+	}
+
+	return b.Append(&AnonymousSegmentData{Ignore: &flag})
+}
+
+// Share 链接分享.
+func (b *MessageBuilder) Share(url, title string) *MessageBuilder {
+	return b.Append(&ShareSegmentData{Url: url, Title: title})
+}
+
+// ContactFriend 推荐好友.
+func (b *MessageBuilder) ContactFriend(userId int64) *MessageBuilder {
+	return b.Append(&ContactSegmentData{Type: ContactSegmentDataTypeQQ, Id: strconv.FormatInt(userId, 10)})
+}
+
+// ContactGroup 推荐群.
+func (b *MessageBuilder) ContactGroup(groupId int64) *MessageBuilder {
+	return b.Append(&ContactSegmentData{Type: ContactSegmentDataTypeGroup, Id: strconv.FormatInt(groupId, 10)})
+}
+
+// Location 位置.
+func (b *MessageBuilder) Location(lat, lon float64) *MessageBuilder {
+	return b.Append(&LocationSegmentData{
+		Lat: strconv.FormatFloat(lat, 'f', -1, 64),
+		Lon: strconv.FormatFloat(lon, 'f', -1, 64),
+	})
+}
+
+// Music 音乐分享（QQ 音乐、网易云音乐、虾米音乐）.
+func (b *MessageBuilder) Music(typ MusicType, id string) *MessageBuilder {
+	return b.Append(&MusicSegmentData{Type: typ, Id: id})
+}
+
+// CustomMusic 音乐自定义分享.
+func (b *MessageBuilder) CustomMusic(url, audio, title string) *MessageBuilder {
+	return b.Append(&MusicSegmentData{Type: MusicTypeCustom, Url: url, Audio: audio, Title: title})
+}
+
+// Reply 回复.
+func (b *MessageBuilder) Reply(messageId int64) *MessageBuilder {
+	return b.Append(&ReplySegmentData{Id: strconv.FormatInt(messageId, 10)})
+}
+
+// Forward 合并转发.
+func (b *MessageBuilder) Forward(id string) *MessageBuilder {
+	return b.Append(&ForwardSegmentData{Id: id})
+}
+
+// Node 合并转发节点.
+func (b *MessageBuilder) Node(messageId int64) *MessageBuilder {
+	return b.Append(&NodeSegmentData{Id: strconv.FormatInt(messageId, 10)})
+}
+
+// CustomNode 合并转发自定义节点.
+func (b *MessageBuilder) CustomNode(userId int64, nickname string, content *MessageValue) *MessageBuilder {
+	return b.Append(&NodeSegmentData{
+		UserId:   strconv.FormatInt(userId, 10),
+		Nickname: nickname,
+		Content:  content,
+	})
+}
+
+// Xml XML 消息.
+func (b *MessageBuilder) Xml(data string) *MessageBuilder {
+	return b.Append(&XmlSegmentData{Data: data})
+}
+
+// Json JSON 消息.
+func (b *MessageBuilder) Json(data string) *MessageBuilder {
+	return b.Append(&JsonSegmentData{Data: data})
+}
+
+// Segments 返回当前已追加的消息段.
+func (b *MessageBuilder) Segments() []*Segment {
+	return append([]*Segment(nil), b.segments...)
+}
+
+// Build 构造数组形式的消息.
+func (b *MessageBuilder) Build() *MessageValue {
+	return &MessageValue{
+		Type:       MessageValueTypeArray,
+		ArrayValue: b.Segments(),
+	}
+}
+
+// BuildString 构造 CQ 码字符串形式的消息.
+func (b *MessageBuilder) BuildString() (*MessageValue, error) {
+	str, err := FormatCQString(b.segments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageValue{
+		Type:        MessageValueTypeString,
+		StringValue: str,
+	}, nil
+}
+
+func isNilSegmentData(data SegmentData) bool {
+	if data == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(data)
+
+	return value.Kind() == reflect.Pointer && value.IsNil()
+}
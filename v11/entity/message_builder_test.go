@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageBuilderBuild(t *testing.T) {
+	t.Parallel()
+
+	ignore := IgnoreFlagYes
+	content := &MessageValue{Type: MessageValueTypeString, StringValue: "c"}
+
+	value := NewMessage().
+		Reply(1).At(2).AtAll().Text("hi").Face(14).Image("a.jpg").FlashImage("b.jpg").
+		Record("c.amr").Video("d.mp4").Rps().Dice().Shake().Poke(PokeSegmentDataShowLove()).
+		Anonymous(true).Share("http://x", "t").ContactFriend(3).ContactGroup(4).Location(39.9, 116.4).
+		Music(MusicTypeQQ, "5").CustomMusic("http://u", "http://a", "song").Forward("f").Node(6).
+		CustomNode(7, "n", content).Xml("<x/>").Json("{}").Append(nil).
+		Build()
+
+	require.Equal(t, MessageValueTypeArray, value.Type)
+	require.Equal(t, []*Segment{
+		NewSegment(&ReplySegmentData{Id: "1"}),
+		NewSegment(&AtSegmentData{QQ: "2"}),
+		NewSegment(&AtSegmentData{QQ: "all"}),
+		NewSegment(&TextSegmentData{Text: "hi"}),
+		NewSegment(&FaceSegmentData{Id: "14"}),
+		NewSegment(&ImageSegmentData{File: "a.jpg"}),
+		NewSegment(&ImageSegmentData{File: "b.jpg", Type: ImageSegmentDataTypeFlash}),
+		NewSegment(&RecordSegmentData{File: "c.amr"}),
+		NewSegment(&VideoSegmentData{File: "d.mp4"}),
+		NewSegment(&RpsSegmentData{}),
+		NewSegment(&DiceSegmentData{}),
+		NewSegment(&ShakeSegmentData{}),
+		NewSegment(PokeSegmentDataShowLove()),
+		NewSegment(&AnonymousSegmentData{Ignore: &ignore}),
+		NewSegment(&ShareSegmentData{Url: "http://x", Title: "t"}),
+		NewSegment(&ContactSegmentData{Type: ContactSegmentDataTypeQQ, Id: "3"}),
+		NewSegment(&ContactSegmentData{Type: ContactSegmentDataTypeGroup, Id: "4"}),
+		NewSegment(&LocationSegmentData{Lat: "39.9", Lon: "116.4"}),
+		NewSegment(&MusicSegmentData{Type: MusicTypeQQ, Id: "5"}),
+		NewSegment(&MusicSegmentData{Type: MusicTypeCustom, Url: "http://u", Audio: "http://a", Title: "song"}),
+		NewSegment(&ForwardSegmentData{Id: "f"}),
+		NewSegment(&NodeSegmentData{Id: "6"}),
+		NewSegment(&NodeSegmentData{UserId: "7", Nickname: "n", Content: content}),
+		NewSegment(&XmlSegmentData{Data: "<x/>"}),
+		NewSegment(&JsonSegmentData{Data: "{}"}),
+	}, value.ArrayValue)
+}
+
+func TestMessageBuilderBuildString(t *testing.T) {
+	t.Parallel()
+
+	value, err := NewMessage().Reply(10).At(20).Text(" hi [x]").Image("a.jpg").BuildString()
+	require.NoError(t, err)
+	require.Equal(t, MessageValueTypeString, value.Type)
+	require.Equal(t, "[CQ:reply,id=10][CQ:at,qq=20] hi &#91;x&#93;[CQ:image,file=a.jpg]", value.StringValue)
+
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	require.JSONEq(t, `"[CQ:reply,id=10][CQ:at,qq=20] hi &#91;x&#93;[CQ:image,file=a.jpg]"`, string(data))
+}
+
+func TestMessageBuilderBuildIsolated(t *testing.T) {
+	t.Parallel()
+
+	builder := NewMessage().Text("a")
+	first := builder.Build()
+
+	builder.Text("b")
+
+	require.Len(t, first.ArrayValue, 1)
+	require.Len(t, builder.Build().ArrayValue, 2)
+}
+
+func TestMessageBuilderSkipsNilSegmentData(t *testing.T) {
+	t.Parallel()
+
+	builder := NewMessage().Text("a").Poke(nil).Append(nil, (*ImageSegmentData)(nil))
+
+	value := builder.Build()
+	require.Len(t, value.ArrayValue, 1)
+	require.Equal(t, SegmentDataTypeText, value.ArrayValue[0].Type)
+
+	str, err := builder.BuildString()
+	require.NoError(t, err)
+	require.Equal(t, "a", str.StringValue)
+}
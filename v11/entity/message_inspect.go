@@ -0,0 +1,182 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+)
+
+// atAllQQ at 消息段中表示全体成员的 qq 值.
+const atAllQQ = "all"
+
+// SegmentList 消息段数组，提供常用的消息内容查询方法.
+type SegmentList []*Segment
+
+// PlainText 返回所有 text 消息段拼接后的纯文本.
+func (l SegmentList) PlainText() string {
+	var builder strings.Builder
+
+	for _, segment := range l {
+		if text, ok := segment.GetData().(*TextSegmentData); ok {
+			builder.WriteString(text.Text)
+		}
+	}
+
+	return builder.String()
+}
+
+// AtUsers 返回被 @ 的 QQ 号列表（按出现顺序去重，不包含 @全体成员）.
+func (l SegmentList) AtUsers() []int64 {
+	var (
+		users []int64
+		seen  = make(map[int64]struct{})
+	)
+
+	for _, segment := range l {
+		at, ok := segment.GetData().(*AtSegmentData)
+		if !ok {
+			continue
+		}
+
+		userId, err := strconv.ParseInt(at.QQ, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := seen[userId]; ok {
+			continue
+		}
+
+		seen[userId] = struct{}{}
+		users = append(users, userId)
+	}
+
+	return users
+}
+
+// IsAt 判断是否 @ 了指定 QQ 号.
+func (l SegmentList) IsAt(userId int64) bool {
+	target := strconv.FormatInt(userId, 10)
+
+	for _, segment := range l {
+		if at, ok := segment.GetData().(*AtSegmentData); ok && at.QQ == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsAtAll 判断是否 @全体成员.
+func (l SegmentList) IsAtAll() bool {
+	for _, segment := range l {
+		if at, ok := segment.GetData().(*AtSegmentData); ok && at.QQ == atAllQQ {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReplyId 返回 reply 消息段引用的消息 ID.
+func (l SegmentList) ReplyId() (int64, bool) {
+	for _, segment := range l {
+		reply, ok := segment.GetData().(*ReplySegmentData)
+		if !ok {
+			continue
+		}
+
+		id, err := strconv.ParseInt(reply.Id, 10, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		return id, true
+	}
+
+	return 0, false
+}
+
+// StripLeadingReplyAndAt 去掉消息开头的 reply、at 消息段以及它们之间的空白文本，
+// 并去掉剩余第一个 text 消息段的前导空白，常用于提取指令文本.
+func (l SegmentList) StripLeadingReplyAndAt() SegmentList {
+	idx := 0
+
+	for ; idx < len(l); idx++ {
+		switch data := l[idx].GetData().(type) {
+		case *ReplySegmentData, *AtSegmentData:
+			continue
+		case *TextSegmentData:
+			if strings.TrimSpace(data.Text) == "" {
+				continue
+			}
+		}
+
+		break
+	}
+
+	rest := append(SegmentList(nil), l[idx:]...)
+	if len(rest) == 0 {
+		return rest
+	}
+
+	if text, ok := rest[0].GetData().(*TextSegmentData); ok {
+		rest[0] = NewSegment(&TextSegmentData{Text: strings.TrimLeft(text.Text, " \t\r\n")})
+	}
+
+	return rest
+}
+
+// SegmentList 返回消息段数组形式的消息内容
+// 字符串形式的消息按 CQ 码解析，解析失败时整体作为一个 text 消息段.
+func (m *MessageValue) SegmentList() SegmentList {
+	segments, err := m.Segments()
+	if err != nil {
+		return SegmentList{NewSegment(&TextSegmentData{Text: UnescapeCQ(m.StringValue)})}
+	}
+
+	return segments
+}
+
+// PlainText 返回消息中的纯文本.
+func (m *MessageValue) PlainText() string {
+	return m.SegmentList().PlainText()
+}
+
+// AtUsers 返回消息中被 @ 的 QQ 号列表.
+func (m *MessageValue) AtUsers() []int64 {
+	return m.SegmentList().AtUsers()
+}
+
+// IsAt 判断消息是否 @ 了指定 QQ 号.
+func (m *MessageValue) IsAt(userId int64) bool {
+	return m.SegmentList().IsAt(userId)
+}
+
+// IsAtAll 判断消息是否 @全体成员.
+func (m *MessageValue) IsAtAll() bool {
+	return m.SegmentList().IsAtAll()
+}
+
+// ReplyId 返回消息引用的消息 ID.
+func (m *MessageValue) ReplyId() (int64, bool) {
+	return m.SegmentList().ReplyId()
+}
+
+// StripLeadingReplyAndAt 返回去掉开头 reply、at 消息段后的消息段.
+func (m *MessageValue) StripLeadingReplyAndAt() SegmentList {
+	return m.SegmentList().StripLeadingReplyAndAt()
+}
+
+// IsToMe 判断消息是否发给机器人，私聊消息总是发给机器人.
+func (e *PrivateMessageEvent) IsToMe() bool {
+	return e != nil
+}
+
+// IsToMe 判断消息是否发给机器人，即消息中 @ 了 SelfId.
+func (e *GroupMessageEvent) IsToMe() bool {
+	if e == nil {
+		return false
+	}
+
+	return e.Message.IsAt(e.SelfId)
+}
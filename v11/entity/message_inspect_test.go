@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func inspectTestMessages(t *testing.T) map[string]*MessageValue {
+	t.Helper()
+
+	const cq = "[CQ:reply,id=99][CQ:at,qq=10001] [CQ:at,qq=20002]  /echo hi[CQ:face,id=1] there[CQ:at,qq=10001]"
+
+	return map[string]*MessageValue{
+		"string": {Type: MessageValueTypeString, StringValue: cq},
+		"array": NewMessage().Reply(99).At(10001).Text(" ").At(20002).Text("  /echo hi").
+			Face(1).Text(" there").At(10001).Build(),
+	}
+}
+
+func TestMessageValueInspect(t *testing.T) {
+	t.Parallel()
+
+	for name, value := range inspectTestMessages(t) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, "   /echo hi there", value.PlainText())
+			require.Equal(t, []int64{10001, 20002}, value.AtUsers())
+			require.True(t, value.IsAt(10001))
+			require.False(t, value.IsAt(30003))
+			require.False(t, value.IsAtAll())
+
+			replyId, ok := value.ReplyId()
+			require.True(t, ok)
+			require.Equal(t, int64(99), replyId)
+
+			stripped := value.StripLeadingReplyAndAt()
+			require.Equal(t, "/echo hi there", stripped.PlainText())
+			require.Equal(t, SegmentDataTypeText, stripped[0].Type)
+			require.Equal(t, []int64{10001}, stripped.AtUsers())
+		})
+	}
+}
+
+func TestMessageValueInspectEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	var nilValue *MessageValue
+
+	require.Empty(t, nilValue.PlainText())
+	require.Empty(t, nilValue.AtUsers())
+	require.Empty(t, nilValue.StripLeadingReplyAndAt())
+
+	_, ok := nilValue.ReplyId()
+	require.False(t, ok)
+
+	atAll := NewMessage().AtAll().Build()
+	require.True(t, atAll.IsAtAll())
+	require.Empty(t, atAll.AtUsers())
+
+	invalid := &MessageValue{Type: MessageValueTypeString, StringValue: "a &amp; [CQ:face"}
+	require.Equal(t, "a & [CQ:face", invalid.PlainText())
+
+	onlyAt := NewMessage().At(1).Text("  ").Build()
+	require.Empty(t, onlyAt.StripLeadingReplyAndAt())
+}
+
+func TestMessageEventIsToMe(t *testing.T) {
+	t.Parallel()
+
+	private := &PrivateMessageEvent{SelfId: 1, Message: NewMessage().Text("hi").Build()}
+	require.True(t, private.IsToMe())
+
+	group := &GroupMessageEvent{SelfId: 1, Message: NewMessage().At(1).Text(" hi").Build()}
+	require.True(t, group.IsToMe())
+
+	group = &GroupMessageEvent{SelfId: 1, Message: &MessageValue{
+		Type: MessageValueTypeString, StringValue: "[CQ:at,qq=2] hi",
+	}}
+	require.False(t, group.IsToMe())
+
+	var nilGroup *GroupMessageEvent
+	require.False(t, nilGroup.IsToMe())
+}
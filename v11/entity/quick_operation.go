@@ -0,0 +1,152 @@
+//go:generate go run ../cmd/entity-gen
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// QuickOperation 事件快速操作
+// 作为 HTTP 事件上报请求的响应数据返回给 OneBot 实现，由实现端执行对应操作.
+type QuickOperation interface {
+	json.Marshaler
+	// IsEmpty 判断是否不包含任何操作，空操作不会返回给 OneBot 实现.
+	IsEmpty() bool
+}
+
+// QuickOperationMap 以 map 表示的快速操作，用于实现端扩展的快速操作字段.
+type QuickOperationMap map[string]any
+
+// IsEmpty 判断是否不包含任何操作.
+func (m QuickOperationMap) IsEmpty() bool {
+	return len(m) == 0
+}
+
+// MarshalJSON 序列化为 JSON 对象.
+func (m QuickOperationMap) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("{}"), nil
+	}
+
+	data, err := json.Marshal(map[string]any(m))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal QuickOperationMap: %w", err)
+	}
+
+	return data, nil
+}
+
+// MessageQuickOperation 消息事件（私聊消息、群消息）的快速操作.
+type MessageQuickOperation struct {
+	// 要回复的内容，不回复时为空
+	Reply *MessageValue `json:"reply,omitempty"`
+	// 消息内容是否作为纯文本发送（即不解析 CQ 码），只在 `reply` 字段是字符串时有效 | 默认值: false
+	AutoEscape *bool `json:"auto_escape,omitempty"`
+	// 是否要在回复开头 at 发送者（自动添加），发送者是匿名用户时无效，仅群消息有效 | 默认值: true
+	AtSender *bool `json:"at_sender,omitempty"`
+	// 撤回该条消息，仅群消息有效 | 默认值: false
+	Delete *bool `json:"delete,omitempty"`
+	// 把发送者踢出群组（需要登录号权限足够），不拒绝此人后续加群请求，发送者是匿名用户时无效，仅群消息有效 | 默认值: false
+	Kick *bool `json:"kick,omitempty"`
+	// 把发送者禁言 `ban_duration` 指定时长，对匿名用户也有效，仅群消息有效 | 默认值: false
+	Ban *bool `json:"ban,omitempty"`
+	// 禁言时长（秒），仅群消息有效 | 默认值: 30 分钟
+	BanDuration *int64 `json:"ban_duration,omitempty"`
+}
+
+// IsEmpty 判断是否不包含任何操作.
+func (q *MessageQuickOperation) IsEmpty() bool {
+	return q == nil || *q == MessageQuickOperation{}
+}
+
+// MarshalJSON 序列化为快速操作 JSON 对象，未设置的字段不输出.
+func (q *MessageQuickOperation) MarshalJSON() ([]byte, error) {
+	type alias MessageQuickOperation
+
+	data, err := json.Marshal((*alias)(q))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageQuickOperation: %w", err)
+	}
+
+	return data, nil
+}
+
+// FriendRequestQuickOperation 加好友请求事件的快速操作.
+type FriendRequestQuickOperation struct {
+	// 是否同意请求 | 默认值: 不处理
+	Approve *bool `json:"approve,omitempty"`
+	// 添加后的好友备注（仅在同意时有效） | 默认值: 空
+	Remark string `json:"remark,omitempty"`
+}
+
+// IsEmpty 判断是否不包含任何操作.
+func (q *FriendRequestQuickOperation) IsEmpty() bool {
+	return q == nil || *q == FriendRequestQuickOperation{}
+}
+
+// MarshalJSON 序列化为快速操作 JSON 对象，未设置的字段不输出.
+func (q *FriendRequestQuickOperation) MarshalJSON() ([]byte, error) {
+	type alias FriendRequestQuickOperation
+
+	data, err := json.Marshal((*alias)(q))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal FriendRequestQuickOperation: %w", err)
+	}
+
+	return data, nil
+}
+
+// GroupRequestQuickOperation 加群请求／邀请事件的快速操作.
+type GroupRequestQuickOperation struct {
+	// 是否同意请求／邀请 | 默认值: 不处理
+	Approve *bool `json:"approve,omitempty"`
+	// 拒绝理由（仅在拒绝时有效） | 默认值: 空
+	Reason string `json:"reason,omitempty"`
+}
+
+// IsEmpty 判断是否不包含任何操作.
+func (q *GroupRequestQuickOperation) IsEmpty() bool {
+	return q == nil || *q == GroupRequestQuickOperation{}
+}
+
+// MarshalJSON 序列化为快速操作 JSON 对象，未设置的字段不输出.
+func (q *GroupRequestQuickOperation) MarshalJSON() ([]byte, error) {
+	type alias GroupRequestQuickOperation
+
+	data, err := json.Marshal((*alias)(q))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal GroupRequestQuickOperation: %w", err)
+	}
+
+	return data, nil
+}
+
+// HandleQuickOperationAction 对事件执行快速操作的隐藏 API
+// 供 WebSocket 等无法通过上报响应返回快速操作的传输层使用.
+const HandleQuickOperationAction = ".handle_quick_operation"
+
+// HandleQuickOperationRequest .handle_quick_operation API 的请求参数
+// 对事件执行快速操作.
+type HandleQuickOperationRequest struct {
+	// 事件数据对象
+	Context map[string]any `json:"context"`
+	// 快速操作对象，例如 `{"ban": true, "reply": "请不要说脏话"}`
+	Operation map[string]any `json:"operation"`
+}
+
+// NewHandleQuickOperationRequest 根据事件与快速操作构造 .handle_quick_operation 请求参数.
+func NewHandleQuickOperationRequest(event Event, op QuickOperation) (*HandleQuickOperationRequest, error) {
+	eventContext, err := util.JsonStructToMap(event)
+	if err != nil {
+		return nil, fmt.Errorf("encode quick operation context: %w", err)
+	}
+
+	operation, err := util.JsonStructToMap(op)
+	if err != nil {
+		return nil, fmt.Errorf("encode quick operation: %w", err)
+	}
+
+	return &HandleQuickOperationRequest{Context: eventContext, Operation: operation}, nil
+}
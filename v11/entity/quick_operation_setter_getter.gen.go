@@ -0,0 +1,264 @@
+// Code generated by entity-gen. DO NOT EDIT.
+
+package entity
+
+// GetReply
+// 要回复的内容，不回复时为空
+func (r *MessageQuickOperation) GetReply() *MessageValue {
+	if r == nil {
+		var zero *MessageValue
+		return zero
+	}
+	return r.Reply
+}
+
+// SetReply
+// 要回复的内容，不回复时为空
+func (r *MessageQuickOperation) SetReply(v *MessageValue) *MessageQuickOperation {
+	r.Reply = v
+	return r
+}
+
+// GetAutoEscape
+// 消息内容是否作为纯文本发送（即不解析 CQ 码），只在 `reply` 字段是字符串时有效 | 默认值: false
+func (r *MessageQuickOperation) GetAutoEscape() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.AutoEscape == nil {
+		var zero bool
+		return zero
+	}
+	return *r.AutoEscape
+}
+
+// SetAutoEscape
+// 消息内容是否作为纯文本发送（即不解析 CQ 码），只在 `reply` 字段是字符串时有效 | 默认值: false
+func (r *MessageQuickOperation) SetAutoEscape(v bool) *MessageQuickOperation {
+	val := v
+	r.AutoEscape = &val
+	return r
+}
+
+// GetAtSender
+// 是否要在回复开头 at 发送者（自动添加），发送者是匿名用户时无效，仅群消息有效 | 默认值: true
+func (r *MessageQuickOperation) GetAtSender() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.AtSender == nil {
+		var zero bool
+		return zero
+	}
+	return *r.AtSender
+}
+
+// SetAtSender
+// 是否要在回复开头 at 发送者（自动添加），发送者是匿名用户时无效，仅群消息有效 | 默认值: true
+func (r *MessageQuickOperation) SetAtSender(v bool) *MessageQuickOperation {
+	val := v
+	r.AtSender = &val
+	return r
+}
+
+// GetDelete
+// 撤回该条消息，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) GetDelete() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Delete == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Delete
+}
+
+// SetDelete
+// 撤回该条消息，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) SetDelete(v bool) *MessageQuickOperation {
+	val := v
+	r.Delete = &val
+	return r
+}
+
+// GetKick
+// 把发送者踢出群组（需要登录号权限足够），不拒绝此人后续加群请求，发送者是匿名用户时无效，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) GetKick() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Kick == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Kick
+}
+
+// SetKick
+// 把发送者踢出群组（需要登录号权限足够），不拒绝此人后续加群请求，发送者是匿名用户时无效，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) SetKick(v bool) *MessageQuickOperation {
+	val := v
+	r.Kick = &val
+	return r
+}
+
+// GetBan
+// 把发送者禁言 `ban_duration` 指定时长，对匿名用户也有效，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) GetBan() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Ban == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Ban
+}
+
+// SetBan
+// 把发送者禁言 `ban_duration` 指定时长，对匿名用户也有效，仅群消息有效 | 默认值: false
+func (r *MessageQuickOperation) SetBan(v bool) *MessageQuickOperation {
+	val := v
+	r.Ban = &val
+	return r
+}
+
+// GetBanDuration
+// 禁言时长（秒），仅群消息有效 | 默认值: 30 分钟
+func (r *MessageQuickOperation) GetBanDuration() int64 {
+	if r == nil {
+		var zero int64
+		return zero
+	}
+	if r.BanDuration == nil {
+		var zero int64
+		return zero
+	}
+	return *r.BanDuration
+}
+
+// SetBanDuration
+// 禁言时长（秒），仅群消息有效 | 默认值: 30 分钟
+func (r *MessageQuickOperation) SetBanDuration(v int64) *MessageQuickOperation {
+	val := v
+	r.BanDuration = &val
+	return r
+}
+
+// GetApprove
+// 是否同意请求 | 默认值: 不处理
+func (r *FriendRequestQuickOperation) GetApprove() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Approve == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Approve
+}
+
+// SetApprove
+// 是否同意请求 | 默认值: 不处理
+func (r *FriendRequestQuickOperation) SetApprove(v bool) *FriendRequestQuickOperation {
+	val := v
+	r.Approve = &val
+	return r
+}
+
+// GetRemark
+// 添加后的好友备注（仅在同意时有效） | 默认值: 空
+func (r *FriendRequestQuickOperation) GetRemark() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Remark
+}
+
+// SetRemark
+// 添加后的好友备注（仅在同意时有效） | 默认值: 空
+func (r *FriendRequestQuickOperation) SetRemark(v string) *FriendRequestQuickOperation {
+	r.Remark = v
+	return r
+}
+
+// GetApprove
+// 是否同意请求／邀请 | 默认值: 不处理
+func (r *GroupRequestQuickOperation) GetApprove() bool {
+	if r == nil {
+		var zero bool
+		return zero
+	}
+	if r.Approve == nil {
+		var zero bool
+		return zero
+	}
+	return *r.Approve
+}
+
+// SetApprove
+// 是否同意请求／邀请 | 默认值: 不处理
+func (r *GroupRequestQuickOperation) SetApprove(v bool) *GroupRequestQuickOperation {
+	val := v
+	r.Approve = &val
+	return r
+}
+
+// GetReason
+// 拒绝理由（仅在拒绝时有效） | 默认值: 空
+func (r *GroupRequestQuickOperation) GetReason() string {
+	if r == nil {
+		var zero string
+		return zero
+	}
+	return r.Reason
+}
+
+// SetReason
+// 拒绝理由（仅在拒绝时有效） | 默认值: 空
+func (r *GroupRequestQuickOperation) SetReason(v string) *GroupRequestQuickOperation {
+	r.Reason = v
+	return r
+}
+
+// GetContext
+// 事件数据对象
+func (r *HandleQuickOperationRequest) GetContext() map[string]any {
+	if r == nil {
+		var zero map[string]any
+		return zero
+	}
+	return r.Context
+}
+
+// SetContext
+// 事件数据对象
+func (r *HandleQuickOperationRequest) SetContext(v map[string]any) *HandleQuickOperationRequest {
+	r.Context = v
+	return r
+}
+
+// GetOperation
+// 快速操作对象，例如 `{"ban": true, "reply": "请不要说脏话"}`
+func (r *HandleQuickOperationRequest) GetOperation() map[string]any {
+	if r == nil {
+		var zero map[string]any
+		return zero
+	}
+	return r.Operation
+}
+
+// SetOperation
+// 快速操作对象，例如 `{"ban": true, "reply": "请不要说脏话"}`
+func (r *HandleQuickOperationRequest) SetOperation(v map[string]any) *HandleQuickOperationRequest {
+	r.Operation = v
+	return r
+}
@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuickOperationMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		op   QuickOperation
+		want string
+	}{
+		{
+			name: "message",
+			op: (&MessageQuickOperation{}).SetReply(NewMessage().Text("hi").Build()).
+				SetAtSender(false).SetBan(true).SetBanDuration(60),
+			want: `{"reply":[{"type":"text","data":{"text":"hi"}}],"at_sender":false,"ban":true,"ban_duration":60}`,
+		},
+		{
+			name: "friend_request",
+			op:   (&FriendRequestQuickOperation{}).SetApprove(true).SetRemark("r"),
+			want: `{"approve":true,"remark":"r"}`,
+		},
+		{
+			name: "group_request",
+			op:   (&GroupRequestQuickOperation{}).SetApprove(false).SetReason("no"),
+			want: `{"approve":false,"reason":"no"}`,
+		},
+		{
+			name: "map",
+			op:   QuickOperationMap{"custom": 1},
+			want: `{"custom":1}`,
+		},
+		{
+			name: "nil_map",
+			op:   QuickOperationMap(nil),
+			want: `{}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(tc.op)
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(data))
+		})
+	}
+}
+
+func TestQuickOperationIsEmpty(t *testing.T) {
+	t.Parallel()
+
+	var (
+		nilMessage *MessageQuickOperation
+		nilFriend  *FriendRequestQuickOperation
+		nilGroup   *GroupRequestQuickOperation
+	)
+
+	require.True(t, nilMessage.IsEmpty())
+	require.True(t, nilFriend.IsEmpty())
+	require.True(t, nilGroup.IsEmpty())
+	require.True(t, (&MessageQuickOperation{}).IsEmpty())
+	require.True(t, (&FriendRequestQuickOperation{}).IsEmpty())
+	require.True(t, (&GroupRequestQuickOperation{}).IsEmpty())
+	require.True(t, QuickOperationMap{}.IsEmpty())
+
+	require.False(t, (&MessageQuickOperation{}).SetDelete(false).IsEmpty())
+	require.False(t, (&FriendRequestQuickOperation{}).SetRemark("r").IsEmpty())
+	require.False(t, (&GroupRequestQuickOperation{}).SetReason("r").IsEmpty())
+}
@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// SegmentDataFactory 创建一个空的消息段数据实例，用于反序列化时填充.
+type SegmentDataFactory func() SegmentData
+
+type segmentDataRegistry struct {
+	mu        sync.RWMutex
+	factories map[SegmentDataType]SegmentDataFactory
+}
+
+//nolint:gochecknoglobals // 消息段类型注册表需要在包级别共享
+var segmentRegistry = &segmentDataRegistry{
+	factories: map[SegmentDataType]SegmentDataFactory{
+		SegmentDataTypeText:      func() SegmentData { return &TextSegmentData{} },
+		SegmentDataTypeFace:      func() SegmentData { return &FaceSegmentData{} },
+		SegmentDataTypeImage:     func() SegmentData { return &ImageSegmentData{} },
+		SegmentDataTypeRecord:    func() SegmentData { return &RecordSegmentData{} },
+		SegmentDataTypeVideo:     func() SegmentData { return &VideoSegmentData{} },
+		SegmentDataTypeAt:        func() SegmentData { return &AtSegmentData{} },
+		SegmentDataTypeRps:       func() SegmentData { return &RpsSegmentData{} },
+		SegmentDataTypeDice:      func() SegmentData { return &DiceSegmentData{} },
+		SegmentDataTypeShake:     func() SegmentData { return &ShakeSegmentData{} },
+		SegmentDataTypePoke:      func() SegmentData { return &PokeSegmentData{} },
+		SegmentDataTypeAnonymous: func() SegmentData { return &AnonymousSegmentData{} },
+		SegmentDataTypeShare:     func() SegmentData { return &ShareSegmentData{} },
+		SegmentDataTypeContact:   func() SegmentData { return &ContactSegmentData{} },
+		SegmentDataTypeLocation:  func() SegmentData { return &LocationSegmentData{} },
+		SegmentDataTypeMusic:     func() SegmentData { return &MusicSegmentData{} },
+		SegmentDataTypeReply:     func() SegmentData { return &ReplySegmentData{} },
+		SegmentDataTypeForward:   func() SegmentData { return &ForwardSegmentData{} },
+		SegmentDataTypeNode:      func() SegmentData { return &NodeSegmentData{} },
+		SegmentDataTypeXml:       func() SegmentData { return &XmlSegmentData{} },
+		SegmentDataTypeJson:      func() SegmentData { return &JsonSegmentData{} },
+	},
+}
+
+// RegisterSegmentType 注册消息段类型及其数据构造函数.
+// 重复注册同一类型会覆盖之前的构造函数，可用于替换内置实现；factory 为 nil 时注销该类型.
+func RegisterSegmentType(typ SegmentDataType, factory SegmentDataFactory) {
+	segmentRegistry.mu.Lock()
+	defer segmentRegistry.mu.Unlock()
+
+	if factory == nil {
+		delete(segmentRegistry.factories, typ)
+
+		return
+	}
+
+	segmentRegistry.factories[typ] = factory
+}
+
+// IsSegmentTypeRegistered 判断消息段类型是否已注册.
+func IsSegmentTypeRegistered(typ SegmentDataType) bool {
+	segmentRegistry.mu.RLock()
+	defer segmentRegistry.mu.RUnlock()
+
+	_, ok := segmentRegistry.factories[typ]
+
+	return ok
+}
+
+// newSegmentData 根据类型创建消息段数据实例，未注册的类型返回 RawSegmentData.
+func newSegmentData(typ SegmentDataType) SegmentData {
+	segmentRegistry.mu.RLock()
+	factory, ok := segmentRegistry.factories[typ]
+	segmentRegistry.mu.RUnlock()
+
+	if !ok {
+		return &RawSegmentData{Type: typ}
+	}
+
+	return factory()
+}
+
+// RawSegmentData 未注册类型的消息段数据
+// 保留原始 JSON，序列化时原样输出.
+type RawSegmentData struct {
+	// 消息段类型
+	Type SegmentDataType
+	// 原始 data 字段
+	Data json.RawMessage
+}
+
+func (s *RawSegmentData) SegmentType() SegmentDataType {
+	return s.Type
+}
+
+// UnmarshalJSON 保存原始 data 字段.
+func (s *RawSegmentData) UnmarshalJSON(data []byte) error {
+	s.Data = append(s.Data[:0], data...)
+
+	return nil
+}
+
+// MarshalJSON 原样输出 data 字段.
+func (s *RawSegmentData) MarshalJSON() ([]byte, error) {
+	if len(s.Data) == 0 {
+		return []byte("{}"), nil
+	}
+
+	return s.Data, nil
+}
+
+// UnmarshalJSON 实现 json.Unmarshaler 接口
+// 根据 type 字段从注册表中选择具体的数据类型；类型不匹配的字段（例如以字符串传递的数字）按 CQ 码相同的规则弱类型转换，
+// 仍无法解码时保留为 RawSegmentData，不影响消息中的其他消息段.
+func (s *Segment) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type SegmentDataType `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal segment: %w", err)
+	}
+
+	s.Type = raw.Type
+	s.Data = nil
+
+	if len(raw.Data) == 0 || bytes.Equal(raw.Data, []byte("null")) {
+		return nil
+	}
+
+	s.Data = decodeSegmentData(raw.Type, raw.Data)
+
+	return nil
+}
+
+// decodeSegmentData 解码消息段数据，严格解码失败时按 CQ 码规则弱类型解码，仍失败时返回保留原始数据的 RawSegmentData.
+func decodeSegmentData(typ SegmentDataType, data json.RawMessage) SegmentData {
+	segmentData := newSegmentData(typ)
+
+	err := json.Unmarshal(data, segmentData)
+	if err == nil {
+		return segmentData
+	}
+
+	var params map[string]any
+
+	err = util.JsonUnmarshalUseNumber(data, &params)
+	if err == nil {
+		segmentData = newSegmentData(typ)
+
+		err = util.JsonTagMappingWeak(params, segmentData, cqMessageValueDecodeHook, util.JsonUnmarshalerDecodeHook)
+		if err == nil {
+			return segmentData
+		}
+	}
+
+	return &RawSegmentData{Type: typ, Data: append(json.RawMessage(nil), data...)}
+}
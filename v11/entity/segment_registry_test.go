@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type customSegmentData struct {
+	Value string `json:"value"`
+}
+
+func (c *customSegmentData) SegmentType() SegmentDataType {
+	return "custom_registry_test"
+}
+
+func TestSegmentUnmarshalJSONBuiltinTypes(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		payload string
+		want    SegmentData
+	}{
+		{
+			name:    "text",
+			payload: `{"type":"text","data":{"text":"hello"}}`,
+			want:    &TextSegmentData{Text: "hello"},
+		},
+		{
+			name:    "image",
+			payload: `{"type":"image","data":{"file":"a.jpg","url":"http://x/a.jpg","type":"flash"}}`,
+			want:    &ImageSegmentData{File: "a.jpg", Url: "http://x/a.jpg", Type: ImageSegmentDataTypeFlash},
+		},
+		{
+			name:    "at",
+			payload: `{"type":"at","data":{"qq":"10001"}}`,
+			want:    &AtSegmentData{QQ: "10001"},
+		},
+		{
+			name:    "poke",
+			payload: `{"type":"poke","data":{"type":"126","id":"2011"}}`,
+			want:    &PokeSegmentData{Type: "126", Id: 2011},
+		},
+		{
+			name:    "dice",
+			payload: `{"type":"dice","data":{}}`,
+			want:    &DiceSegmentData{},
+		},
+		{
+			name:    "node",
+			payload: `{"type":"node","data":{"user_id":"1","nickname":"n","content":"hi"}}`,
+			want: &NodeSegmentData{
+				UserId:   "1",
+				Nickname: "n",
+				Content:  &MessageValue{Type: MessageValueTypeString, StringValue: "hi"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var segment Segment
+
+			err := json.Unmarshal([]byte(tc.payload), &segment)
+			require.NoError(t, err)
+			require.Equal(t, tc.want.SegmentType(), segment.Type)
+			require.Equal(t, tc.want, segment.Data)
+		})
+	}
+}
+
+func TestSegmentUnmarshalJSONAllBuiltinTypesRegistered(t *testing.T) {
+	t.Parallel()
+
+	types := []SegmentDataType{
+		SegmentDataTypeText, SegmentDataTypeFace, SegmentDataTypeImage, SegmentDataTypeRecord,
+		SegmentDataTypeVideo, SegmentDataTypeAt, SegmentDataTypeRps, SegmentDataTypeDice,
+		SegmentDataTypeShake, SegmentDataTypePoke, SegmentDataTypeAnonymous, SegmentDataTypeShare,
+		SegmentDataTypeContact, SegmentDataTypeLocation, SegmentDataTypeMusic, SegmentDataTypeReply,
+		SegmentDataTypeForward, SegmentDataTypeNode, SegmentDataTypeXml, SegmentDataTypeJson,
+	}
+
+	for _, typ := range types {
+		require.True(t, IsSegmentTypeRegistered(typ), typ)
+		require.Equal(t, typ, newSegmentData(typ).SegmentType())
+	}
+}
+
+func TestSegmentUnmarshalJSONUnknownType(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"type":"mface","data":{"emoji_id":"abc","summary":"[x]"}}`
+
+	var segment Segment
+
+	err := json.Unmarshal([]byte(payload), &segment)
+	require.NoError(t, err)
+	require.Equal(t, SegmentDataType("mface"), segment.Type)
+
+	raw, ok := segment.Data.(*RawSegmentData)
+	require.True(t, ok)
+	require.Equal(t, SegmentDataType("mface"), raw.SegmentType())
+	require.JSONEq(t, `{"emoji_id":"abc","summary":"[x]"}`, string(raw.Data))
+
+	out, err := json.Marshal(&segment)
+	require.NoError(t, err)
+	require.JSONEq(t, payload, string(out))
+}
+
+func TestSegmentUnmarshalJSONInvalidData(t *testing.T) {
+	t.Parallel()
+
+	var segment Segment
+
+	// 类型不匹配的字段按弱类型转换
+	err := json.Unmarshal([]byte(`{"type":"text","data":{"text":1}}`), &segment)
+	require.NoError(t, err)
+	require.Equal(t, &TextSegmentData{Text: "1"}, segment.Data)
+
+	// 无法解码的数据保留为 RawSegmentData，序列化时原样输出
+	err = json.Unmarshal([]byte(`{"type":"image","data":{"file":["a.jpg"]}}`), &segment)
+	require.NoError(t, err)
+
+	raw, ok := segment.Data.(*RawSegmentData)
+	require.True(t, ok)
+	require.Equal(t, SegmentDataTypeImage, raw.SegmentType())
+
+	out, err := json.Marshal(&segment)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"image","data":{"file":["a.jpg"]}}`, string(out))
+
+	err = json.Unmarshal([]byte(`{"type":"text","data":`), &segment)
+	require.Error(t, err)
+
+	var value MessageValue
+
+	err = json.Unmarshal([]byte(`[1]`), &value)
+	require.ErrorContains(t, err, "unmarshal array value")
+}
+
+func TestSegmentUnmarshalJSONWeakParity(t *testing.T) {
+	t.Parallel()
+
+	// go-cqhttp 风格的数组消息以字符串传递数字，与 CQ 码解析结果一致
+	fromCQ, err := ParseCQString("[CQ:image,file=a.jpg,timeout=60]")
+	require.NoError(t, err)
+
+	var fromArray MessageValue
+
+	err = json.Unmarshal([]byte(`[{"type":"image","data":{"file":"a.jpg","timeout":"60"}}]`), &fromArray)
+	require.NoError(t, err)
+	require.Equal(t, fromCQ, fromArray.ArrayValue)
+
+	event, err := ParseEvent([]byte(`{"time":1,"self_id":1,"post_type":"message","message_type":"group",` +
+		`"sub_type":"normal","message_id":1,"group_id":2,"user_id":3,"raw_message":"","font":0,` +
+		`"message":[{"type":"image","data":{"file":"a.jpg","timeout":"60"}}]}`))
+	require.NoError(t, err)
+
+	msg, ok := event.(*GroupMessageEvent)
+	require.True(t, ok)
+	require.Equal(t, fromCQ, msg.Message.ArrayValue)
+}
+
+func TestRegisterSegmentType(t *testing.T) {
+	t.Parallel()
+
+	typ := (&customSegmentData{}).SegmentType()
+	RegisterSegmentType(typ, func() SegmentData { return &customSegmentData{} })
+	t.Cleanup(func() { RegisterSegmentType(typ, nil) })
+
+	require.True(t, IsSegmentTypeRegistered(typ))
+
+	var value MessageValue
+
+	err := json.Unmarshal([]byte(`[{"type":"custom_registry_test","data":{"value":"v"}}]`), &value)
+	require.NoError(t, err)
+	require.Len(t, value.ArrayValue, 1)
+	require.Equal(t, &customSegmentData{Value: "v"}, value.ArrayValue[0].Data)
+}
+
+func TestRawSegmentDataMarshalJSONEmpty(t *testing.T) {
+	t.Parallel()
+
+	out, err := json.Marshal(NewSegment(&RawSegmentData{Type: "unknown"}))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"type":"unknown","data":{}}`, string(out))
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // OneBot 11 规定 X-Signature 使用 HMAC-SHA1
+	"encoding/hex"
+)
+
+// SignatureHeader 上报请求的签名请求头.
+const SignatureHeader = "X-Signature"
+
+// SignBody 使用 secret 计算 body 的 HMAC-SHA1 签名，返回 X-Signature 请求头的值，格式为 `sha1=<hex>`.
+func SignBody(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature 以常量时间比较 X-Signature 请求头的值与 body 的 HMAC-SHA1 签名.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(SignBody(secret, body)), []byte(signature))
+}
@@ -0,0 +1,25 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignBody(t *testing.T) {
+	t.Parallel()
+
+	// echo -n '{"post_type":"message"}' | openssl dgst -sha1 -hmac secret
+	require.Equal(t, "sha1=337a43569f539f97888127f17fefc625794e5e06", SignBody("secret", []byte(`{"post_type":"message"}`)))
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"post_type":"message"}`)
+
+	require.True(t, VerifySignature("secret", body, SignBody("secret", body)))
+	require.False(t, VerifySignature("secret", body, ""))
+	require.False(t, VerifySignature("secret", body, SignBody("other", body)))
+	require.False(t, VerifySignature("secret", []byte(`{"post_type":"notice"}`), SignBody("secret", body)))
+}
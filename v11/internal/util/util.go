@@ -1,9 +1,11 @@
 package util
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
@@ -11,29 +13,224 @@ import (
 
 var errInvalidMapPointer = errors.New("map pointer cannot be nil")
 
-// JsonUnmarshalToMapAndStruct unmarshal JSON data into a map and a struct.
-func JsonUnmarshalToMapAndStruct(data []byte, dest any, destMap *map[string]any) error {
+// JsonUnmarshalWithOrigin 将 JSON 反序列化到结构体，同时将全部原始字段保存到 map 中.
+// 结构体部分使用 encoding/json 解码，嵌套类型的 UnmarshalJSON 会生效.
+// 原始字段中的数字保存为 json.Number，超出 float64 精度的整数（例如频道 ID）也能原样序列化.
+func JsonUnmarshalWithOrigin(data []byte, dest any, destMap *map[string]any) error {
 	if destMap == nil {
 		return errInvalidMapPointer
 	}
 
-	err := json.Unmarshal(data, destMap)
+	err := json.Unmarshal(data, dest)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal JSON to struct: %w", err)
+	}
+
+	var origin map[string]any
+
+	err = JsonUnmarshalUseNumber(data, &origin)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal JSON to map: %w", err)
 	}
 
-	err = JsonTagMapping(*destMap, dest)
+	*destMap = origin
+
+	return nil
+}
+
+// JsonUnmarshalUseNumber 反序列化 JSON，interface{} 中的数字解码为 json.Number 而不是 float64.
+func JsonUnmarshalUseNumber(data []byte, dest any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	err := decoder.Decode(dest)
 	if err != nil {
-		return fmt.Errorf("failed to map JSON data to struct: %w", err)
+		return fmt.Errorf("failed to decode JSON: %w", err)
 	}
 
 	return nil
 }
 
-func JsonTagMapping(source, dest any) error {
+// JsonStructToMap 通过 JSON 序列化将结构体转换为 map，与实际传输的字段名及 omitempty 行为一致.
+// 数字保存为 json.Number，不会丢失超出 float64 精度的整数.
+func JsonStructToMap(src any) (map[string]any, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	var result map[string]any
+
+	err = JsonUnmarshalUseNumber(data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// JsonMarshalWithOrigin 序列化结构体，并合并 origin 中结构体未声明的字段.
+// 结构体已声明的字段以结构体的当前值为准；原始数据中不存在且仍为零值的已声明字段会被省略，
+// 以保证反序列化后再序列化能够还原原始数据.
+func JsonMarshalWithOrigin(src any, origin map[string]any) ([]byte, error) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal struct: %w", err)
+	}
+
+	if len(origin) == 0 {
+		return data, nil
+	}
+
+	var known map[string]json.RawMessage
+
+	err = json.Unmarshal(data, &known)
+	if err != nil || known == nil {
+		// 非对象类型，无法合并
+		return data, nil //nolint:nilerr
+	}
+
+	declared := make(map[string]reflect.Value)
+	collectJsonFields(reflect.ValueOf(src), declared)
+
+	merged := make(map[string]any, len(origin)+len(known))
+
+	for k, v := range origin {
+		if _, ok := declared[k]; !ok {
+			merged[k] = v
+		}
+	}
+
+	for k, v := range known {
+		if _, ok := origin[k]; !ok && declared[k].IsValid() && declared[k].IsZero() {
+			continue
+		}
+
+		merged[k] = v
+	}
+
+	data, err = json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal merged fields: %w", err)
+	}
+
+	return data, nil
+}
+
+// collectJsonFields 收集结构体声明的全部 JSON 字段名及其对应的值.
+func collectJsonFields(val reflect.Value, fields map[string]reflect.Value) {
+	for val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return
+		}
+
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	typ := val.Type()
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			collectJsonFields(val.Field(i), fields)
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = val.Field(i)
+	}
+}
+
+func JsonTagMapping(source, dest any, hooks ...mapstructure.DecodeHookFunc) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:     dest,
+		TagName:    "json",
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(hooks...),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create decoder: %w", err)
+	}
+
+	err = decoder.Decode(source)
+	if err != nil {
+		return fmt.Errorf("failed to decode data to struct: %w", err)
+	}
+
+	return nil
+}
+
+// JsonUnmarshalerDecodeHook 目标类型实现了 json.Unmarshaler 时，通过 JSON 序列化转换数据，
+// 使 MessageValue 等自定义反序列化的类型可以从 map、字符串或数组中还原.
+func JsonUnmarshalerDecodeHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if data == nil {
+		return data, nil
+	}
+
+	target := to
+	if target.Kind() == reflect.Pointer {
+		target = target.Elem()
+	}
+
+	source := from
+	if source.Kind() == reflect.Pointer {
+		source = source.Elem()
+	}
+
+	if source == target {
+		return data, nil
+	}
+
+	if !reflect.PointerTo(target).Implements(reflect.TypeFor[json.Unmarshaler]()) {
+		return data, nil
+	}
+
+	bs, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", from, err)
+	}
+
+	value := reflect.New(target)
+
+	err = json.Unmarshal(bs, value.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", to, err)
+	}
+
+	if to.Kind() == reflect.Pointer {
+		return value.Interface(), nil
+	}
+
+	return value.Elem().Interface(), nil
+}
+
+// JsonTagMappingWeak 与 JsonTagMapping 相同，但允许弱类型转换（例如 "1" -> 1），
+// 并可附加自定义的 DecodeHook.
+func JsonTagMappingWeak(source, dest any, hooks ...mapstructure.DecodeHookFunc) error {
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		Result:  dest,
-		TagName: "json",
+		Result:           dest,
+		TagName:          "json",
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.ComposeDecodeHookFunc(hooks...),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create decoder: %w", err)
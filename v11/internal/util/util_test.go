@@ -2,229 +2,12 @@ package util
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
-// TestJsonUnmarshalToMapAndStructBasic 测试基本的反序列化功能.
-func TestJsonUnmarshalToMapAndStructBasic(t *testing.T) {
-	t.Parallel()
-
-	type Person struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
-
-	jsonData := []byte(`{"name": "Alice", "age": 30}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-	require.NoError(t, err)
-
-	require.Equal(t, "Alice", person.Name)
-	require.Equal(t, 30, person.Age)
-	require.Equal(t, "Alice", originMap["name"])
-	require.InEpsilon(t, float64(30), originMap["age"], 0)
-}
-
-// TestUnmarshalToMapAndStructNested 测试嵌套结构的反序列化.
-func TestUnmarshalToMapAndStructNested(t *testing.T) {
-	t.Parallel()
-
-	type Address struct {
-		City string `json:"city"`
-	}
-
-	type Person struct {
-		Name    string  `json:"name"`
-		Address Address `json:"address"`
-	}
-
-	jsonData := []byte(`{
-		"name": "Bob",
-		"address": {
-			"city": "New York"
-		}
-	}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-	require.NoError(t, err)
-
-	require.Equal(t, "Bob", person.Name)
-	require.Equal(t, "New York", person.Address.City)
-
-	addressMap, ok := originMap["address"].(map[string]any)
-	require.True(t, ok)
-	require.Equal(t, "New York", addressMap["city"])
-}
-
-// TestUnmarshalToMapAndStructExtraFields 测试包含额外字段的 JSON.
-func TestUnmarshalToMapAndStructExtraFields(t *testing.T) {
-	t.Parallel()
-
-	type Person struct {
-		Name string `json:"name"`
-	}
-
-	jsonData := []byte(`{
-		"name": "Charlie",
-		"email": "charlie@example.com",
-		"phone": "123-456-7890"
-	}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-	require.NoError(t, err)
-
-	require.Equal(t, "Charlie", person.Name)
-	require.Equal(t, "charlie@example.com", originMap["email"])
-	require.Equal(t, "123-456-7890", originMap["phone"])
-}
-
-// TestUnmarshalToMapAndStructArray 测试数组类型.
-func TestUnmarshalToMapAndStructArray(t *testing.T) {
-	t.Parallel()
-
-	type Data struct {
-		Items []string `json:"items"`
-		Count int      `json:"count"`
-	}
-
-	jsonData := []byte(`{
-		"items": ["apple", "banana", "cherry"],
-		"count": 3
-	}`)
-
-	var (
-		data      Data
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &data, &originMap)
-	require.NoError(t, err)
-
-	require.Len(t, data.Items, 3)
-	require.Equal(t, "apple", data.Items[0])
-
-	itemsArray, ok := originMap["items"].([]any)
-	require.True(t, ok)
-	require.Len(t, itemsArray, 3)
-}
-
-// TestUnmarshalToMapAndStructEmptyJSON 测试空 JSON 对象.
-func TestUnmarshalToMapAndStructEmptyJSON(t *testing.T) {
-	t.Parallel()
-
-	type Person struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
-
-	jsonData := []byte(`{}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-	require.NoError(t, err)
-
-	require.Empty(t, person.Name)
-	require.Zero(t, person.Age)
-	require.Empty(t, originMap)
-}
-
-// TestUnmarshalToMapAndStructNullValues 测试包含 null 值的 JSON.
-func TestUnmarshalToMapAndStructNullValues(t *testing.T) {
-	t.Parallel()
-
-	type Data struct {
-		Name     *string `json:"name"`
-		Optional *string `json:"optional"`
-	}
-
-	jsonData := []byte(`{
-		"name": "David",
-		"optional": null
-	}`)
-
-	var (
-		data      Data
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &data, &originMap)
-	require.NoError(t, err)
-
-	require.NotNil(t, data.Name)
-	require.Equal(t, "David", *data.Name)
-	require.Nil(t, data.Optional)
-	require.Nil(t, originMap["optional"])
-}
-
-// TestUnmarshalToMapAndStructInvalidJSON 测试无效的 JSON.
-func TestUnmarshalToMapAndStructInvalidJSON(t *testing.T) {
-	t.Parallel()
-
-	type Person struct {
-		Name string `json:"name"`
-	}
-
-	jsonData := []byte(`{invalid json}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-	require.Error(t, err)
-}
-
-// TestUnmarshalToMapAndStructTypeConversion 测试类型转换.
-func TestUnmarshalToMapAndStructTypeConversion(t *testing.T) {
-	t.Parallel()
-
-	type Data struct {
-		Count  int     `json:"count"`
-		Price  float64 `json:"price"`
-		Active bool    `json:"active"`
-	}
-
-	jsonData := []byte(`{
-		"count": 42,
-		"price": 19.99,
-		"active": true
-	}`)
-
-	var (
-		data      Data
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &data, &originMap)
-	require.NoError(t, err)
-
-	require.Equal(t, 42, data.Count)
-	require.InEpsilon(t, 19.99, data.Price, 0)
-	require.True(t, data.Active)
-}
-
 func TestNormalizePath(t *testing.T) {
 	t.Parallel()
 
@@ -248,355 +31,142 @@ func TestNormalizePath(t *testing.T) {
 	}
 }
 
-// TestUnmarshalToMapAndStructUnicodeCharacters 测试 Unicode 字符.
-func TestUnmarshalToMapAndStructUnicodeCharacters(t *testing.T) {
+// TestJsonTagMappingWeak 测试弱类型转换与自定义 DecodeHook.
+func TestJsonTagMappingWeak(t *testing.T) {
 	t.Parallel()
 
-	type Person struct {
-		Name string `json:"name"`
+	type Target struct {
+		Count   int64  `json:"count,string"`
+		Enabled bool   `json:"enabled"`
+		Name    string `json:"name,omitempty"`
 	}
 
-	jsonData := []byte(`{"name": "Foo"}`)
+	var target Target
 
-	var (
-		person    Person
-		originMap map[string]any
-	)
+	upper := func(from reflect.Type, _ reflect.Type, data any) (any, error) {
+		if from.Kind() == reflect.String && data == "lower" {
+			return "LOWER", nil
+		}
+
+		return data, nil
+	}
 
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
+	err := JsonTagMappingWeak(map[string]any{"count": "42", "enabled": "1", "name": "lower"}, &target, upper)
 	require.NoError(t, err)
+	require.Equal(t, Target{Count: 42, Enabled: true, Name: "LOWER"}, target)
 
-	expectedName := "Foo"
-	require.Equal(t, expectedName, person.Name)
-	require.Equal(t, expectedName, originMap["name"])
+	err = JsonTagMappingWeak(map[string]any{"count": "abc"}, &target)
+	require.Error(t, err)
 }
 
-// TestUnmarshalToMapAndStructLargeNumbers 测试大数字.
-func TestUnmarshalToMapAndStructLargeNumbers(t *testing.T) {
+// TestJsonUnmarshalWithOrigin 测试结构体与原始字段同时反序列化.
+func TestJsonUnmarshalWithOrigin(t *testing.T) {
 	t.Parallel()
 
-	type Data struct {
-		BigInt   int64   `json:"big_int"`
-		BigFloat float64 `json:"big_float"`
+	type Target struct {
+		Name string          `json:"name"`
+		Raw  json.RawMessage `json:"raw"`
 	}
 
-	jsonData := []byte(`{
-		"big_int": 9007199254740991,
-		"big_float": 1.7976931348623157e+308
-	}`)
-
 	var (
-		data      Data
-		originMap map[string]any
+		target Target
+		origin map[string]any
 	)
 
-	err := JsonUnmarshalToMapAndStruct(jsonData, &data, &originMap)
+	err := JsonUnmarshalWithOrigin([]byte(`{"name":"a","raw":[1, 2],"extra":"x"}`), &target, &origin)
 	require.NoError(t, err)
+	require.Equal(t, "a", target.Name)
+	require.JSONEq(t, `[1,2]`, string(target.Raw))
+	require.Equal(t, "x", origin["extra"])
 
-	require.Equal(t, int64(9007199254740991), data.BigInt)
-	require.InEpsilon(t, 1.7976931348623157e+308, data.BigFloat, 0)
-
-	bigIntVal, ok := originMap["big_int"].(float64)
-	require.True(t, ok)
-	require.InEpsilon(t, 9007199254740991.0, bigIntVal, 0)
-
-	bigFloatVal, ok := originMap["big_float"].(float64)
-	require.True(t, ok)
-	require.InEpsilon(t, 1.7976931348623157e+308, bigFloatVal, 0)
-}
-
-// TestUnmarshalToMapAndStructMapPointerNil 测试 map 指针为 nil.
-func TestUnmarshalToMapAndStructMapPointerNil(t *testing.T) {
-	t.Parallel()
-
-	type Person struct {
-		Name string `json:"name"`
-	}
-
-	jsonData := []byte(`{"name": "Eve"}`)
-
-	var (
-		person Person
-		nilMap *map[string]any
-	)
+	err = JsonUnmarshalWithOrigin([]byte(`{}`), &target, nil)
+	require.Error(t, err)
 
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, nilMap)
+	err = JsonUnmarshalWithOrigin([]byte(`{"name":1}`), &target, &origin)
 	require.Error(t, err)
-	require.Equal(t, "map pointer cannot be nil", err.Error())
 }
 
-// TestUnmarshalToMapAndStructComplexStructure 测试复杂的嵌套结构.
-func TestUnmarshalToMapAndStructComplexStructure(t *testing.T) {
+// TestJsonMarshalWithOrigin 测试序列化时合并原始字段.
+func TestJsonMarshalWithOrigin(t *testing.T) {
 	t.Parallel()
 
-	type Contact struct {
-		Email string `json:"email"`
-		Phone string `json:"phone"`
+	type Embedded struct {
+		Inner string `json:"inner"`
 	}
 
-	type Address struct {
-		Street string `json:"street"`
-		City   string `json:"city"`
-	}
+	type Target struct {
+		Embedded
 
-	type Person struct {
-		Name    string  `json:"name"`
-		Contact Contact `json:"contact"`
-		Address Address `json:"address"`
+		Name    string `json:"name"`
+		Count   int64  `json:"count"`
+		Ignored string `json:"-"`
 	}
 
-	jsonData := []byte(`{
-		"name": "Frank",
-		"contact": {
-			"email": "frank@example.com",
-			"phone": "555-1234"
-		},
-		"address": {
-			"street": "123 Main St",
-			"city": "Springfield"
-		}
-	}`)
-
-	var (
-		person    Person
-		originMap map[string]any
-	)
+	origin := map[string]any{"name": "old", "inner": "old", "extra": "x", "Ignored": "y"}
 
-	err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
+	data, err := JsonMarshalWithOrigin(&Target{Embedded: Embedded{Inner: "i"}, Name: "new"}, origin)
 	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"new","inner":"i","extra":"x","Ignored":"y"}`, string(data))
 
-	require.Equal(t, "Frank", person.Name)
-	require.Equal(t, "frank@example.com", person.Contact.Email)
-	require.Equal(t, "123 Main St", person.Address.Street)
-
-	require.Equal(t, "Frank", originMap["name"])
-
-	contactMap, ok := originMap["contact"].(map[string]any)
-	require.True(t, ok)
-	require.Equal(t, "frank@example.com", contactMap["email"])
-	require.Equal(t, "555-1234", contactMap["phone"])
-
-	addressMap, ok := originMap["address"].(map[string]any)
-	require.True(t, ok)
-	require.Equal(t, "123 Main St", addressMap["street"])
-	require.Equal(t, "Springfield", addressMap["city"])
-}
-
-// TestUnmarshalToMapAndStructWithSpecialCharacters 测试包含特殊字符的字符串.
-func TestUnmarshalToMapAndStructWithSpecialCharacters(t *testing.T) {
-	t.Parallel()
-
-	type Data struct {
-		Text string `json:"text"`
-	}
-
-	jsonData := []byte(`{"text": "Line1\nLine2\tTabbed"}`)
-
-	var (
-		data      Data
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &data, &originMap)
+	data, err = JsonMarshalWithOrigin(&Target{Count: 1}, nil)
 	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"","inner":"","count":1}`, string(data))
 
-	expected := "Line1\nLine2\tTabbed"
-	require.Equal(t, expected, data.Text)
-	require.Equal(t, expected, originMap["text"])
+	data, err = JsonMarshalWithOrigin([]int{1}, origin)
+	require.NoError(t, err)
+	require.JSONEq(t, `[1]`, string(data))
 }
 
-// TestUnmarshalToMapAndStructBooleanValues 测试布尔值.
-func TestUnmarshalToMapAndStructBooleanValues(t *testing.T) {
+// TestJsonStructToMap 测试结构体转换为 map，整数不丢失精度.
+func TestJsonStructToMap(t *testing.T) {
 	t.Parallel()
 
-	type Flags struct {
-		Enabled bool `json:"enabled"`
-		Active  bool `json:"active"`
+	type Target struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name,omitempty"`
+		Flags []bool `json:"flags"`
 	}
 
-	jsonData := []byte(`{
-		"enabled": true,
-		"active": false
-	}`)
-
-	var (
-		flags     Flags
-		originMap map[string]any
-	)
-
-	err := JsonUnmarshalToMapAndStruct(jsonData, &flags, &originMap)
+	result, err := JsonStructToMap(&Target{ID: 144115218676755431, Flags: []bool{true}})
 	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": json.Number("144115218676755431"), "flags": []any{true}}, result)
 
-	require.True(t, flags.Enabled)
-	require.False(t, flags.Active)
-
-	enabledVal, ok := originMap["enabled"].(bool)
-	require.True(t, ok)
-	require.True(t, enabledVal)
+	_, err = JsonStructToMap([]int{1})
+	require.Error(t, err)
 
-	activeVal, ok := originMap["active"].(bool)
-	require.True(t, ok)
-	require.False(t, activeVal)
+	_, err = JsonStructToMap(func() {})
+	require.Error(t, err)
 }
 
-// BenchmarkUnmarshalToMapAndStructSmallPayload 小负载性能测试.
-func BenchmarkUnmarshalToMapAndStructSmallPayload(b *testing.B) {
-	type Person struct {
-		Name string `json:"name"`
-		Age  int    `json:"age"`
-	}
-
-	jsonData := []byte(`{"name": "Alice", "age": 30}`)
-
-	b.ResetTimer()
-
-	for range b.N {
-		var (
-			person    Person
-			originMap map[string]any
-		)
-
-		err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-		require.NoError(b, err)
-		require.Equal(b, "Alice", person.Name)
-		require.Equal(b, 30, person.Age)
-		require.Equal(b, "Alice", originMap["name"])
-		require.InEpsilon(b, float64(30), originMap["age"], 0)
-	}
+type unmarshalerValue struct {
+	Value string
 }
 
-// BenchmarkUnmarshalToMapAndStructMediumPayload 中等负载性能测试.
-func BenchmarkUnmarshalToMapAndStructMediumPayload(b *testing.B) {
-	type Person struct {
-		Name    string
-		Age     int
-		Email   string
-		Phone   string
-		Address string
-		City    string
-		Country string
-	}
+func (v *unmarshalerValue) UnmarshalJSON(data []byte) error {
+	v.Value = string(data)
 
-	jsonData := []byte(`{
-		"name": "Alice",
-		"age": 30,
-		"email": "alice@example.com",
-		"phone": "123-456-7890",
-		"address": "123 Main St",
-		"city": "New York",
-		"country": "USA"
-	}`)
-
-	b.ResetTimer()
-
-	for range b.N {
-		var (
-			person    Person
-			originMap map[string]any
-		)
-
-		err := JsonUnmarshalToMapAndStruct(jsonData, &person, &originMap)
-		require.NoError(b, err)
-		require.Equal(b, "Alice", person.Name)
-		require.Equal(b, 30, person.Age)
-		require.Equal(b, "alice@example.com", person.Email)
-		require.Equal(b, "Alice", originMap["name"])
-		require.InEpsilon(b, float64(30), originMap["age"], 0)
-		require.Equal(b, "alice@example.com", originMap["email"])
-	}
+	return nil
 }
 
-// BenchmarkUnmarshalToMapAndStructLargePayload 大负载性能测试.
-func BenchmarkUnmarshalToMapAndStructLargePayload(b *testing.B) {
-	type Item struct {
-		ID    int    `json:"id"`
-		Name  string `json:"name"`
-		Value string `json:"value"`
-	}
-
-	type Data struct {
-		Items []Item `json:"items"`
-	}
-
-	// 创建包含 100 个项的大 JSON 负载
-	items := make([]Item, 100)
-	for i := range 100 {
-		items[i] = Item{ID: i, Name: "item", Value: "value"}
-	}
-
-	data := Data{Items: items}
-
-	jsonData, err := json.Marshal(data)
-	if err != nil {
-		b.Fatalf("failed to marshal data: %v", err)
-	}
-
-	b.ResetTimer()
-
-	for range b.N {
-		var (
-			result    Data
-			originMap map[string]any
-		)
-
-		err := JsonUnmarshalToMapAndStruct(jsonData, &result, &originMap)
-		require.NoError(b, err)
-		require.Len(b, result.Items, 100)
-		require.Equal(b, 0, result.Items[0].ID)
-		require.Equal(b, "item", result.Items[0].Name)
-
-		itemsArray, ok := originMap["items"].([]any)
-		require.True(b, ok)
-		require.Len(b, itemsArray, 100)
-	}
-}
+// TestJsonUnmarshalerDecodeHook 测试实现 json.Unmarshaler 的字段通过 JSON 还原.
+func TestJsonUnmarshalerDecodeHook(t *testing.T) {
+	t.Parallel()
 
-// BenchmarkUnmarshalToMapAndStructDeepNesting 深层嵌套性能测试.
-func BenchmarkUnmarshalToMapAndStructDeepNesting(b *testing.B) {
-	type Level4 struct {
-		Value string `json:"value"`
+	type Target struct {
+		Ptr   *unmarshalerValue `json:"ptr"`
+		Value unmarshalerValue  `json:"value"`
+		Count int64             `json:"count"`
 	}
 
-	type Level3 struct {
-		Level4 Level4 `json:"level4"`
-	}
+	var target Target
 
-	type Level2 struct {
-		Level3 Level3 `json:"level3"`
-	}
+	err := JsonTagMapping(map[string]any{"ptr": "a", "value": []any{1}, "count": 1}, &target, JsonUnmarshalerDecodeHook)
+	require.NoError(t, err)
+	require.Equal(t, Target{Ptr: &unmarshalerValue{Value: `"a"`}, Value: unmarshalerValue{Value: `[1]`}, Count: 1}, target)
 
-	type Level1 struct {
-		Level2 Level2 `json:"level2"`
-	}
+	ptr := &unmarshalerValue{Value: "same"}
 
-	jsonData := []byte(`{
-		"level2": {
-			"level3": {
-				"level4": {
-					"value": "deep"
-				}
-			}
-		}
-	}`)
-
-	b.ResetTimer()
-
-	for range b.N {
-		var (
-			level1    Level1
-			originMap map[string]any
-		)
-
-		err := JsonUnmarshalToMapAndStruct(jsonData, &level1, &originMap)
-		require.NoError(b, err)
-		require.Equal(b, "deep", level1.Level2.Level3.Level4.Value)
-
-		level2Map, ok := originMap["level2"].(map[string]any)
-		require.True(b, ok)
-		level3Map, ok := level2Map["level3"].(map[string]any)
-		require.True(b, ok)
-		level4Map, ok := level3Map["level4"].(map[string]any)
-		require.True(b, ok)
-		require.Equal(b, "deep", level4Map["value"])
-	}
+	err = JsonTagMapping(map[string]any{"ptr": ptr}, &target, JsonUnmarshalerDecodeHook)
+	require.NoError(t, err)
+	require.Equal(t, ptr, target.Ptr)
 }
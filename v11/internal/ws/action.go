@@ -53,7 +53,7 @@ func invalidJSONResponse() *entity.ActionResponseEnvelope {
 	return &entity.ActionResponseEnvelope{
 		ActionRawResponse: entity.ActionRawResponse{
 			Status:  entity.StatusFailed,
-			Retcode: entity.ActionResponseRetcode(1400),
+			Retcode: entity.RetcodeBadRequest,
 			Message: "invalid json",
 		},
 	}
@@ -64,19 +64,20 @@ func mapHandlerError(err error, badRequestErr error) *entity.ActionRawResponse {
 	case errors.Is(err, dispatcher.ErrActionNotFound):
 		return &entity.ActionRawResponse{
 			Status:  entity.StatusFailed,
-			Retcode: 1404,
+			Retcode: entity.RetcodeNotFound,
 			Message: err.Error(),
 		}
-	case badRequestErr != nil && errors.Is(err, badRequestErr):
+	case errors.Is(err, dispatcher.ErrInvalidQuickOperation),
+		badRequestErr != nil && errors.Is(err, badRequestErr):
 		return &entity.ActionRawResponse{
 			Status:  entity.StatusFailed,
-			Retcode: 1400,
+			Retcode: entity.RetcodeBadRequest,
 			Message: err.Error(),
 		}
 	default:
 		return &entity.ActionRawResponse{
 			Status:  entity.StatusFailed,
-			Retcode: 1500,
+			Retcode: entity.RetcodeInternalError,
 			Message: err.Error(),
 		}
 	}
@@ -102,6 +102,19 @@ func TestHandleActionMessageHandlerBadRequest(t *testing.T) {
 	require.Equal(t, "wrap: "+ErrBadRequest.Error(), resp.Message)
 }
 
+func TestHandleActionMessageInvalidQuickOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := dispatcher.NewDispatcher()
+	d.Register(entity.HandleQuickOperationAction, dispatcher.QuickOperationHandler(d))
+
+	resp := HandleActionMessage(ctx, []byte(`{"action":".handle_quick_operation","params":{}}`), d, ErrBadRequest)
+
+	require.Equal(t, entity.StatusFailed, resp.Status)
+	require.Equal(t, entity.RetcodeBadRequest, resp.Retcode)
+}
+
 func TestHandleActionMessageHandlerOtherError(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,142 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// ErrConnClosed 表示连接已断开，等待中的动作调用以该错误失败.
+var ErrConnClosed = errors.New("websocket connection closed")
+
+// Conn 封装 WebSocket 连接，串行化写入，并通过 echo 在连接上调用动作.
+type Conn struct {
+	conn         *websocket.Conn
+	pending      *PendingCalls
+	writeTimeout time.Duration
+	writeMu      sync.Mutex
+}
+
+// NewConn 创建连接封装，writeTimeout 为 0 时不设置写入超时.
+func NewConn(conn *websocket.Conn, writeTimeout time.Duration) *Conn {
+	return &Conn{
+		conn:         conn,
+		pending:      NewPendingCalls(),
+		writeTimeout: writeTimeout,
+	}
+}
+
+// Raw 返回底层连接.
+func (c *Conn) Raw() *websocket.Conn {
+	return c.conn
+}
+
+// WriteJSON 串行写入 JSON 消息.
+func (c *Conn) WriteJSON(v any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if c.writeTimeout > 0 {
+		err := c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout))
+		if err != nil {
+			return fmt.Errorf("%w: set write deadline: %w", entity.ErrNetwork, err)
+		}
+	}
+
+	err := c.conn.WriteJSON(v)
+	if err != nil {
+		return fmt.Errorf("%w: write message: %w", entity.ErrNetwork, err)
+	}
+
+	return nil
+}
+
+// Call 发送动作请求并等待 echo 相同的响应.
+func (c *Conn) Call(ctx context.Context, req *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+	return c.pending.Call(ctx, req, func(env *entity.ActionRequestEnvelope) error {
+		return c.WriteJSON(env)
+	})
+}
+
+// Resolve 将收到的消息交付给等待中的调用，消息不是动作响应时返回 false.
+func (c *Conn) Resolve(data []byte) bool {
+	return c.pending.Resolve(data)
+}
+
+// Close 关闭连接，并以 cause 结束等待中的调用.
+func (c *Conn) Close(cause error) {
+	_ = c.conn.Close()
+
+	c.pending.FailAll(fmt.Errorf("%w: %w: %w", entity.ErrNetwork, ErrConnClosed, cause))
+}
+
+// ConnHolder 保存当前可用的连接，断线重连期间调用方可等待新连接建立.
+type ConnHolder struct {
+	mu        sync.Mutex
+	conn      *Conn
+	connected chan struct{} // 建立连接时关闭
+}
+
+// NewConnHolder 创建 ConnHolder.
+func NewConnHolder() *ConnHolder {
+	return &ConnHolder{connected: make(chan struct{})}
+}
+
+// Current 返回当前连接，未连接时返回 nil.
+func (h *ConnHolder) Current() *Conn {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.conn
+}
+
+// Set 设置当前连接并唤醒等待方，已有连接时替换为 conn.
+func (h *ConnHolder) Set(conn *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.conn = conn
+
+	select {
+	case <-h.connected:
+	default:
+		close(h.connected)
+	}
+}
+
+// Clear 在 conn 仍为当前连接时清除.
+func (h *ConnHolder) Clear(conn *Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.conn == conn {
+		h.conn = nil
+		h.connected = make(chan struct{})
+	}
+}
+
+// Wait 等待连接可用，done 关闭时返回 closedErr.
+func (h *ConnHolder) Wait(ctx context.Context, done <-chan struct{}, closedErr error) (*Conn, error) {
+	for {
+		h.mu.Lock()
+		conn, connected := h.conn, h.connected
+		h.mu.Unlock()
+
+		if conn != nil {
+			return conn, nil
+		}
+
+		select {
+		case <-connected:
+		case <-done:
+			return nil, fmt.Errorf("%w: %w", entity.ErrNetwork, closedErr)
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: wait connection: %w", entity.ErrNetwork, ctx.Err())
+		}
+	}
+}
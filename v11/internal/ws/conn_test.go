@@ -0,0 +1,34 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTestHolderClosed = errors.New("holder closed")
+
+func TestConnHolderSetReplacesConn(t *testing.T) {
+	t.Parallel()
+
+	holder := NewConnHolder()
+	first, second := NewConn(nil, 0), NewConn(nil, 0)
+
+	holder.Set(first)
+	holder.Set(second)
+	require.Same(t, second, holder.Current())
+
+	// 清除非当前连接不影响当前连接
+	holder.Clear(first)
+
+	conn, err := holder.Wait(context.Background(), nil, errTestHolderClosed)
+	require.NoError(t, err)
+	require.Same(t, second, conn)
+
+	holder.Clear(second)
+	require.Nil(t, holder.Current())
+	holder.Set(first)
+	require.Same(t, first, holder.Current())
+}
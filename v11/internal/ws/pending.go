@@ -0,0 +1,115 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// pendingResult 等待中的动作调用结果.
+type pendingResult struct {
+	resp *entity.ActionRawResponse
+	err  error
+}
+
+// PendingCalls 通过 echo 字段关联 WebSocket 上的动作请求与响应，可被多个 goroutine 并发使用.
+type PendingCalls struct {
+	seq atomic.Uint64
+
+	mu    sync.Mutex
+	calls map[string]chan pendingResult
+}
+
+// NewPendingCalls 创建 PendingCalls.
+func NewPendingCalls() *PendingCalls {
+	return &PendingCalls{calls: make(map[string]chan pendingResult)}
+}
+
+// Call 为请求分配唯一 echo，通过 send 发送后等待对应的响应
+// ctx 取消时放弃等待并移除该调用，之后到达的响应会被丢弃.
+func (p *PendingCalls) Call(
+	ctx context.Context,
+	req *entity.ActionRequest,
+	send func(env *entity.ActionRequestEnvelope) error,
+) (*entity.ActionRawResponse, error) {
+	echo := json.RawMessage(strconv.Quote("echo-" + strconv.FormatUint(p.seq.Add(1), 10)))
+	key := string(echo)
+	resultCh := make(chan pendingResult, 1)
+
+	p.mu.Lock()
+	p.calls[key] = resultCh
+	p.mu.Unlock()
+
+	defer p.remove(key)
+
+	err := send(&entity.ActionRequestEnvelope{ActionRequest: *req, Echo: echo})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case result := <-resultCh:
+		return result.resp, result.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("wait action %s response: %w", req.Action, ctx.Err())
+	}
+}
+
+// Resolve 将收到的消息交付给对应的调用
+// 消息不是动作响应（例如 Universal 连接上的事件）或没有匹配的调用时返回 false.
+func (p *PendingCalls) Resolve(data []byte) bool {
+	var env entity.ActionResponseEnvelope
+
+	err := json.Unmarshal(data, &env)
+	if err != nil || len(env.Echo) == 0 || env.Status == "" {
+		return false
+	}
+
+	p.mu.Lock()
+	resultCh, ok := p.calls[string(bytes.TrimSpace(env.Echo))]
+	p.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	select {
+	case resultCh <- pendingResult{resp: &env.ActionRawResponse}:
+	default:
+	}
+
+	return true
+}
+
+// FailAll 以 err 结束所有等待中的调用，通常在连接断开时调用.
+func (p *PendingCalls) FailAll(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, resultCh := range p.calls {
+		select {
+		case resultCh <- pendingResult{err: err}:
+		default:
+		}
+	}
+}
+
+// Len 返回等待中的调用数量.
+func (p *PendingCalls) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.calls)
+}
+
+func (p *PendingCalls) remove(key string) {
+	p.mu.Lock()
+	delete(p.calls, key)
+	p.mu.Unlock()
+}
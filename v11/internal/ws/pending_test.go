@@ -0,0 +1,131 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingCallsResolveOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	pending := NewPendingCalls()
+	sent := make(chan *entity.ActionRequestEnvelope, 2)
+	send := func(env *entity.ActionRequestEnvelope) error {
+		sent <- env
+
+		return nil
+	}
+
+	var wg sync.WaitGroup
+
+	results := make(map[string]string)
+
+	var mu sync.Mutex
+
+	for _, action := range []string{"first", "second"} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := pending.Call(context.Background(), &entity.ActionRequest{Action: action}, send)
+			if err != nil {
+				return
+			}
+
+			mu.Lock()
+			results[action] = string(resp.Data)
+			mu.Unlock()
+		}()
+	}
+
+	envs := []*entity.ActionRequestEnvelope{<-sent, <-sent}
+	require.NotEqual(t, string(envs[0].Echo), string(envs[1].Echo))
+
+	// 倒序响应
+	for i := len(envs) - 1; i >= 0; i-- {
+		data, err := json.Marshal(&entity.ActionResponseEnvelope{
+			ActionRawResponse: entity.ActionRawResponse{
+				Status: entity.StatusOK,
+				Data:   json.RawMessage(`"` + envs[i].Action + `"`),
+			},
+			Echo: envs[i].Echo,
+		})
+		require.NoError(t, err)
+		require.True(t, pending.Resolve(data))
+	}
+
+	wg.Wait()
+
+	require.Equal(t, map[string]string{"first": `"first"`, "second": `"second"`}, results)
+	require.Zero(t, pending.Len())
+}
+
+func TestPendingCallsResolveIgnoresNonResponse(t *testing.T) {
+	t.Parallel()
+
+	pending := NewPendingCalls()
+
+	require.False(t, pending.Resolve([]byte(`{"post_type":"meta_event","meta_event_type":"heartbeat"}`)))
+	require.False(t, pending.Resolve([]byte(`{"status":"ok","retcode":0,"echo":"unknown"}`)))
+	require.False(t, pending.Resolve([]byte(`not json`)))
+}
+
+func TestPendingCallsContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	pending := NewPendingCalls()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	resp, err := pending.Call(ctx, &entity.ActionRequest{Action: "ping"}, func(*entity.ActionRequestEnvelope) error {
+		cancel()
+
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, resp)
+	require.Zero(t, pending.Len())
+}
+
+func TestPendingCallsSendError(t *testing.T) {
+	t.Parallel()
+
+	pending := NewPendingCalls()
+
+	send := func(*entity.ActionRequestEnvelope) error {
+		return ErrOther
+	}
+
+	_, err := pending.Call(context.Background(), &entity.ActionRequest{Action: "ping"}, send)
+	require.ErrorIs(t, err, ErrOther)
+	require.Zero(t, pending.Len())
+}
+
+func TestPendingCallsFailAll(t *testing.T) {
+	t.Parallel()
+
+	pending := NewPendingCalls()
+	sent := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	send := func(*entity.ActionRequestEnvelope) error {
+		close(sent)
+
+		return nil
+	}
+
+	go func() {
+		_, err := pending.Call(context.Background(), &entity.ActionRequest{Action: "ping"}, send)
+		errCh <- err
+	}()
+
+	<-sent
+	pending.FailAll(ErrOther)
+
+	require.ErrorIs(t, <-errCh, ErrOther)
+}
@@ -1,6 +1,10 @@
 package server
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
 
 var (
 
@@ -8,16 +12,26 @@ var (
 	ErrBadRequest = errors.New("bad request")
 	// ErrUniversalClientURLEmpty 表示 universal client URL 为空.
 	ErrUniversalClientURLEmpty = errors.New("universal client URL is empty")
-	// ErrMissingTypeField 表示缺少类型字段.
-	ErrMissingTypeField = errors.New("missing type field")
-	// ErrUnknownEventType 表示未知的事件类型.
-	ErrUnknownEventType = errors.New("unknown event type")
+	// ErrMissingTypeField 表示缺少类型字段，等同于 entity.ErrMissingTypeField.
+	ErrMissingTypeField = entity.ErrMissingTypeField
+	// ErrUnknownEventType 表示未知的事件类型，等同于 entity.ErrUnknownEventType.
+	ErrUnknownEventType = entity.ErrUnknownEventType
 	// ErrInvalidEventTreeStructure 表示事件树结构无效.
+	//
+	// Deprecated: 事件解析已移至 entity.ParseEvent，不再返回该错误.
 	ErrInvalidEventTreeStructure = errors.New("invalid event tree structure")
-	// ErrMissingOrInvalidPostType 表示缺少或无效的 post_type 字段.
-	ErrMissingOrInvalidPostType = errors.New("missing or invalid post_type field")
-	// ErrUnknownPostType 表示未知的 post_type.
-	ErrUnknownPostType = errors.New("unknown post_type")
+	// ErrMissingOrInvalidPostType 表示缺少或无效的 post_type 字段，等同于 entity.ErrMissingOrInvalidPostType.
+	ErrMissingOrInvalidPostType = entity.ErrMissingOrInvalidPostType
+	// ErrUnknownPostType 表示未知的 post_type，等同于 entity.ErrUnknownPostType.
+	ErrUnknownPostType = entity.ErrUnknownPostType
 	// ErrNoEventHandler 表示没有匹配的事件处理器.
 	ErrNoEventHandler = errors.New("no event handler")
+	// ErrInvalidSelfID 表示反向 WebSocket 握手缺少或携带无效的 X-Self-ID 请求头.
+	ErrInvalidSelfID = errors.New("missing or invalid X-Self-ID")
+	// ErrInvalidClientRole 表示反向 WebSocket 握手携带无效的 X-Client-Role 请求头.
+	ErrInvalidClientRole = errors.New("invalid X-Client-Role")
+	// ErrBotNotConnected 表示机器人没有可用于调用动作的反向 WebSocket 连接.
+	ErrBotNotConnected = errors.New("bot not connected")
+	// ErrEventQueueFull 表示事件缓冲区或等待处理的事件队列已满，事件被丢弃.
+	ErrEventQueueFull = errors.New("event queue full")
 )
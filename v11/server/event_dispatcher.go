@@ -10,14 +10,31 @@ import (
 
 // EventDispatcher 根据事件类型字段路由到对应 handler.
 type EventDispatcher struct {
-	handlers map[string]EventHandler
+	handlers           map[string]EventHandler
+	ignoreSelfMessages bool
 }
 
 var _ EventRequestHandler = (*EventDispatcher)(nil)
 
+// EventDispatcherOption 事件分发器的配置选项.
+type EventDispatcherOption func(*EventDispatcher)
+
+// WithIgnoreSelfMessages 忽略机器人自身发送的消息，避免回复自己的消息造成循环.
+// 忽略 message_sent 上报，以及发送者为机器人自身的消息事件，被忽略的事件不会调用任何 handler.
+func WithIgnoreSelfMessages() EventDispatcherOption {
+	return func(d *EventDispatcher) {
+		d.ignoreSelfMessages = true
+	}
+}
+
 // NewEventDispatcher 创建事件分发器.
-func NewEventDispatcher() *EventDispatcher {
-	return &EventDispatcher{handlers: make(map[string]EventHandler)}
+func NewEventDispatcher(opts ...EventDispatcherOption) *EventDispatcher {
+	d := &EventDispatcher{handlers: make(map[string]EventHandler)}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
 }
 
 // Register 注册事件处理器.
@@ -30,12 +47,18 @@ func NewEventDispatcher() *EventDispatcher {
 //   - "notice/notify/poke" - 群内戳一戳
 //   - "request/friend" - 好友请求
 //   - "meta_event/lifecycle" - 生命周期事件
+//
+// 未注册类型的事件（*entity.UnknownEvent）按相同格式的键分发，例如 "notice/group_name".
 func (d *EventDispatcher) Register(key string, h EventHandler) {
 	d.handlers[key] = h
 }
 
 // HandleEvent 调用对应事件 handler.
-func (d *EventDispatcher) HandleEvent(ctx context.Context, event entity.Event) (map[string]any, error) {
+func (d *EventDispatcher) HandleEvent(ctx context.Context, event entity.Event) (entity.QuickOperation, error) {
+	if d.ignoreSelfMessages && IsSelfMessage(event) {
+		return nil, nil //nolint:nilnil // 忽略自身消息，没有快速操作
+	}
+
 	keys := d.buildEventKeys(event)
 
 	// 按优先级尝试匹配：最具体的优先
@@ -49,8 +72,27 @@ func (d *EventDispatcher) HandleEvent(ctx context.Context, event entity.Event) (
 	return nil, ErrNoEventHandler
 }
 
+// IsSelfMessage 判断事件是否为机器人自身发送的消息
+// 包括 message_sent 上报，以及部分实现以 message 上报、发送者为机器人自身的消息.
+func IsSelfMessage(event entity.Event) bool {
+	switch ev := event.(type) {
+	case *entity.PrivateMessageSentEvent, *entity.GroupMessageSentEvent:
+		return true
+	case *entity.PrivateMessageEvent:
+		return ev.SelfId != 0 && ev.UserId == ev.SelfId
+	case *entity.GroupMessageEvent:
+		return ev.SelfId != 0 && ev.UserId == ev.SelfId
+	default:
+		return event != nil && event.GetPostType() == entity.EventPostTypeMessageSent
+	}
+}
+
 // buildEventKeys 根据事件类型字段构建可能的匹配键，按优先级从高到低排序（最具体的优先）.
 func (d *EventDispatcher) buildEventKeys(event entity.Event) []string {
+	if unknown, ok := event.(*entity.UnknownEvent); ok {
+		return d.buildUnknownEventKeys(unknown)
+	}
+
 	postType := event.GetPostType()
 	postTypeStr := string(postType)
 
@@ -63,7 +105,7 @@ func (d *EventDispatcher) buildEventKeys(event entity.Event) []string {
 	var keys []string
 
 	switch postType {
-	case entity.EventPostTypeMessage:
+	case entity.EventPostTypeMessage, entity.EventPostTypeMessageSent:
 		keys = d.buildMessageKeys(postTypeStr, eventMap)
 
 	case entity.EventPostTypeNotice:
@@ -82,6 +124,25 @@ func (d *EventDispatcher) buildEventKeys(event entity.Event) []string {
 	return keys
 }
 
+// buildUnknownEventKeys 构建未注册类型事件的键，格式与已知事件一致.
+func (d *EventDispatcher) buildUnknownEventKeys(event *entity.UnknownEvent) []string {
+	var keys []string
+
+	postTypeStr := string(event.GetPostType())
+
+	if detailType := event.GetDetailType(); detailType != "" {
+		if subType := event.GetSubType(); subType != "" {
+			keys = append(keys, fmt.Sprintf("%s/%s/%s", postTypeStr, detailType, subType))
+		}
+
+		keys = append(keys, fmt.Sprintf("%s/%s", postTypeStr, detailType))
+	}
+
+	keys = append(keys, postTypeStr)
+
+	return keys
+}
+
 // parseEventToMap 将事件解析为 map.
 func (d *EventDispatcher) parseEventToMap(event entity.Event) (map[string]any, error) {
 	eventJSON, err := json.Marshal(event)
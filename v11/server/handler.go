@@ -7,17 +7,17 @@ import (
 )
 
 // EventHandler 处理事件，返回快速操作响应（可选）.
-// 如果返回 nil，则返回 204 No Content.
-type EventHandler func(ctx context.Context, event entity.Event) (map[string]any, error)
+// 如果返回 nil 或空的快速操作，则返回 204 No Content.
+type EventHandler func(ctx context.Context, event entity.Event) (entity.QuickOperation, error)
 
 // EventRequestHandler 处理事件请求.
 type EventRequestHandler interface {
-	HandleEvent(ctx context.Context, event entity.Event) (map[string]any, error)
+	HandleEvent(ctx context.Context, event entity.Event) (entity.QuickOperation, error)
 }
 
 // EventRequestHandlerFunc 适配函数.
-type EventRequestHandlerFunc func(ctx context.Context, event entity.Event) (map[string]any, error)
+type EventRequestHandlerFunc func(ctx context.Context, event entity.Event) (entity.QuickOperation, error)
 
-func (f EventRequestHandlerFunc) HandleEvent(ctx context.Context, event entity.Event) (map[string]any, error) {
+func (f EventRequestHandlerFunc) HandleEvent(ctx context.Context, event entity.Event) (entity.QuickOperation, error) {
 	return f(ctx, event)
 }
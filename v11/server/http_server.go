@@ -1,4 +1,5 @@
 //go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config.yaml -http-server-actions-register-output=./http_server_actions_register.gen.go
+//go:generate go run ../cmd/bindings-gen -config=../cmd/bindings-gen/config_ext.yaml -http-server-actions-register-output=./http_server_ext_actions_register.gen.go
 //go:generate go run ../cmd/event-bindings-gen -config=../cmd/event-bindings-gen/config.yaml -http-server-events-register-output=./http_server_events_register.gen.go
 package server
 
@@ -10,7 +11,6 @@ import (
 	"io"
 	"net/http"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
@@ -34,6 +34,7 @@ type HTTPConfig struct {
 	WriteTimeout      time.Duration
 	IdleTimeout       time.Duration
 	AccessToken       string // 可选鉴权，若为空则不校验
+	Secret            string // 可选事件上报签名密钥，若不为空则校验 X-Signature
 }
 
 // HTTPServer 实现 OneBot HTTP 传输层.
@@ -44,6 +45,7 @@ type HTTPServer struct {
 	cfg           HTTPConfig
 	actionHandler dispatcher.ActionRequestHandler
 	eventHandler  EventRequestHandler // 可选的事件处理器
+	sessions      *SessionRegistry    // 可选的会话注册表
 }
 
 // HTTPServerOption 用于配置 HTTPServer 的选项函数类型.
@@ -112,6 +114,13 @@ func WithAccessToken(token string) HTTPServerOption {
 	}
 }
 
+// WithSecret 设置事件上报签名密钥.
+func WithSecret(secret string) HTTPServerOption {
+	return func(s *HTTPServer) {
+		s.cfg.Secret = secret
+	}
+}
+
 // WithActionHandler 设置动作请求处理器选项.
 func WithActionHandler(actionHandler dispatcher.ActionRequestHandler) HTTPServerOption {
 	return func(s *HTTPServer) {
@@ -126,6 +135,14 @@ func WithEventHandler(eventHandler EventRequestHandler) HTTPServerOption {
 	}
 }
 
+// WithHTTPSessionRegistry 设置会话注册表，收到上报事件时按 X-Self-ID（缺省时使用事件的 self_id）登记机器人
+// HTTP 上报没有连接状态，登记的机器人不会自动下线.
+func WithHTTPSessionRegistry(registry *SessionRegistry) HTTPServerOption {
+	return func(s *HTTPServer) {
+		s.sessions = registry
+	}
+}
+
 // NewHTTPServer 创建 HTTPServer，配置由 opts 提供.
 func NewHTTPServer(opts ...HTTPServerOption) *HTTPServer {
 	mux := http.NewServeMux()
@@ -226,14 +243,31 @@ func (s *HTTPServer) handleEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 请求体只读取一次，同时用于签名校验与事件解析
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+
+		return
+	}
+
+	// 校验上报签名
+	if sigErr := s.checkSignature(r, body); sigErr != nil {
+		http.Error(w, sigErr.message, sigErr.code)
+
+		return
+	}
+
 	// 解析事件 JSON
-	event, err := s.parseEvent(r)
+	event, err := s.parseEvent(body)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 
 		return
 	}
 
+	s.trackSession(r, event)
+
 	// 如果没有事件处理器，返回 204
 	if s.eventHandler == nil {
 		w.WriteHeader(http.StatusNoContent)
@@ -250,7 +284,7 @@ func (s *HTTPServer) handleEvent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// 如果没有快速操作，返回 204
-	if len(quickOp) == 0 {
+	if quickOp == nil || quickOp.IsEmpty() {
 		w.WriteHeader(http.StatusNoContent)
 
 		return
@@ -260,180 +294,27 @@ func (s *HTTPServer) handleEvent(w http.ResponseWriter, r *http.Request) {
 	s.writeJSON(w, http.StatusOK, quickOp)
 }
 
-// rawEvent 原始事件结构体，包含所有可能的事件字段（冗余字段）.
-type rawEvent struct {
-	Time          int64                   `json:"time"`
-	SelfId        int64                   `json:"self_id"`
-	PostType      entity.EventPostType    `json:"post_type"`
-	MessageType   entity.EventMessageType `json:"message_type,omitempty"`
-	NoticeType    entity.EventNoticeType  `json:"notice_type,omitempty"`
-	RequestType   entity.EventRequestType `json:"request_type,omitempty"`
-	MetaEventType entity.EventMetaType    `json:"meta_event_type,omitempty"`
-	SubType       string                  `json:"sub_type,omitempty"`
-}
-
-// eventTreeValue 存储第二层树的值，可以是直接的事件构造函数或第三层树.
-type eventTreeValue struct {
-	constructor     func() entity.Event
-	nextLevelGetter func(event *rawEvent) string
-	subTree         *util.RadixTreeStrKey[*eventTreeValue]
-}
-
-var (
-	// 使用多层嵌套 Radix Tree 实现前缀匹配，完全避免字符串拼接和分配
-	// 第一层：按 post_type 分类，第二层：按 type_field 分类，第三层（可选）：按 sub_type 分类.
-	// 这些全局变量用于延迟初始化，确保只初始化一次
-	//nolint:gochecknoglobals // 延迟初始化的全局变量是合理的
-	eventRadixTree *util.RadixTree[entity.EventPostType, *eventTreeValue]
-	//nolint:gochecknoglobals // 延迟初始化的全局变量是合理的
-	eventRadixOnce sync.Once
-)
-
-// initEventTypeMap 初始化事件类型多层 Radix Tree，使用高性能前缀匹配，完全避免字符串拼接.
-func initEventTypeMap() {
-	// 第一层：按 post_type 分类
-	eventRadixTree = util.NewRadixTreeFromMap(map[entity.EventPostType]*eventTreeValue{
-		// message 类型：message.private, message.group
-		entity.EventPostTypeMessage: {
-			nextLevelGetter: func(event *rawEvent) string {
-				return string(event.MessageType)
-			},
-			subTree: util.NewRadixTreeFromMap(map[string]*eventTreeValue{
-				string(entity.EventMessageTypePrivate): {
-					constructor: func() entity.Event { return &entity.PrivateMessageEvent{} }},
-				string(entity.EventMessageTypeGroup): {
-					constructor: func() entity.Event { return &entity.GroupMessageEvent{} }},
-			}),
-		},
-		// notice 类型
-		entity.EventPostTypeNotice: {
-			nextLevelGetter: func(event *rawEvent) string {
-				return string(event.NoticeType)
-			},
-			subTree: util.NewRadixTreeFromMap(map[string]*eventTreeValue{
-				string(entity.EventNoticeTypeGroupUpload): {
-					constructor: func() entity.Event { return &entity.GroupFileUploadEvent{} }},
-				string(entity.EventNoticeTypeGroupAdmin): {
-					constructor: func() entity.Event { return &entity.GroupAdminChangeEvent{} }},
-				string(entity.EventNoticeTypeGroupDecrease): {
-					constructor: func() entity.Event { return &entity.GroupMemberDecreaseEvent{} }},
-				string(entity.EventNoticeTypeGroupIncrease): {
-					constructor: func() entity.Event { return &entity.GroupMemberIncreaseEvent{} }},
-				string(entity.EventNoticeTypeGroupBan): {
-					constructor: func() entity.Event { return &entity.GroupBanEvent{} }},
-				string(entity.EventNoticeTypeFriendAdd): {
-					constructor: func() entity.Event { return &entity.FriendAddEvent{} }},
-				string(entity.EventNoticeTypeGroupRecall): {
-					constructor: func() entity.Event { return &entity.GroupRecallEvent{} }},
-				string(entity.EventNoticeTypeFriendRecall): {
-					constructor: func() entity.Event { return &entity.FriendRecallEvent{} }},
-				string(entity.EventNoticeTypeNotify): {
-					subTree: util.NewRadixTreeFromMap(map[string]*eventTreeValue{
-						string(entity.EventNoticeSubTypeGroupPoke): {
-							constructor: func() entity.Event { return &entity.GroupPokeEvent{} }},
-						string(entity.EventNoticeSubTypeGroupLuckyKing): {
-							constructor: func() entity.Event { return &entity.GroupLuckyKingEvent{} }},
-						string(entity.EventNoticeSubTypeGroupHonor): {
-							constructor: func() entity.Event { return &entity.GroupHonorChangeEvent{} }},
-					})},
-			}),
-		},
-		// request 类型：request.friend, request.group
-		entity.EventPostTypeRequest: {
-			nextLevelGetter: func(event *rawEvent) string {
-				return string(event.RequestType)
-			},
-			subTree: util.NewRadixTreeFromMap(map[string]*eventTreeValue{
-				string(entity.EventRequestTypeFriend): {
-					constructor: func() entity.Event { return &entity.FriendRequestEvent{} }},
-				string(entity.EventRequestTypeGroup): {
-					constructor: func() entity.Event { return &entity.GroupRequestEvent{} }},
-			}),
-		},
-		// meta_event 类型：meta_event.lifecycle, meta_event.heartbeat
-		entity.EventPostTypeMetaEvent: {
-			nextLevelGetter: func(event *rawEvent) string {
-				return string(event.MetaEventType)
-			},
-			subTree: util.NewRadixTreeFromMap(map[string]*eventTreeValue{
-				string(entity.EventMetaTypeLifecycle): {
-					constructor: func() entity.Event { return &entity.LifecycleEvent{} }},
-				string(entity.EventMetaTypeHeartbeat): {
-					constructor: func() entity.Event { return &entity.HeartbeatEvent{} }},
-			}),
-		},
-	})
-}
-
-// findEventConstructor 递归查找事件构造函数.
-func findEventConstructor(treeValue *eventTreeValue, raw *rawEvent, path []string) (func() entity.Event, error) {
-	// 如果直接有构造函数，直接返回
-	if treeValue.constructor != nil {
-		return treeValue.constructor, nil
-	}
-
-	// 如果有子树，继续递归查找
-	if treeValue.subTree != nil && treeValue.nextLevelGetter != nil {
-		nextKey := treeValue.nextLevelGetter(raw)
-		if nextKey == "" {
-			return nil, fmt.Errorf("%w at path: %s", ErrMissingTypeField, strings.Join(path, "."))
-		}
-
-		nextValue, ok := treeValue.subTree.Get(nextKey)
-		if !ok {
-			return nil, fmt.Errorf("%w: %s.%s", ErrUnknownEventType, strings.Join(path, "."), nextKey)
-		}
-
-		return findEventConstructor(nextValue, raw, append(path, nextKey))
-	}
-
-	return nil, fmt.Errorf("%w at path: %s", ErrInvalidEventTreeStructure, strings.Join(path, "."))
-}
-
-// parseEvent 解析事件 JSON 为具体的事件类型.
-// 使用多层嵌套 Radix Tree 实现前缀匹配，完全避免字符串拼接，通过递归查找实现高性能.
-func (s *HTTPServer) parseEvent(r *http.Request) (entity.Event, error) {
-	// 读取完整的 Body 内容
-	bodyBytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read request body: %w", err)
-	}
-
-	// 解析为原始事件结构体（包含冗余字段）以获取类型信息
-	var raw rawEvent
-
-	err = json.Unmarshal(bodyBytes, &raw)
-	if err != nil {
-		return nil, fmt.Errorf("invalid event json: %w", err)
-	}
-
-	// 验证 post_type 是否存在
-	if raw.PostType == "" {
-		return nil, ErrMissingOrInvalidPostType
+// trackSession 将上报事件的机器人登记到会话注册表.
+func (s *HTTPServer) trackSession(r *http.Request, event entity.Event) {
+	if s.sessions == nil {
+		return
 	}
 
-	// 确保 Radix Tree 已初始化（只初始化一次）
-	eventRadixOnce.Do(initEventTypeMap)
-
-	// 从第一层开始递归查找
-	firstLayerValue, ok := eventRadixTree.Get(raw.PostType)
+	selfID, ok := parseSelfIDHeader(r)
 	if !ok {
-		return nil, fmt.Errorf("%w: %s", ErrUnknownPostType, raw.PostType)
+		selfID = event.GetSelfId()
 	}
 
-	// 递归查找构造函数
-	constructor, err := findEventConstructor(firstLayerValue, &raw, []string{string(raw.PostType)})
-	if err != nil {
-		return nil, err
+	if selfID > 0 {
+		s.sessions.Seen(selfID, SessionTransportHTTP)
 	}
+}
 
-	// 创建事件实例
-	event := constructor()
-
-	// 统一解析 JSON 到具体事件类型
-	err = json.Unmarshal(bodyBytes, event)
+// parseEvent 将请求体解析为具体的事件类型.
+func (s *HTTPServer) parseEvent(body []byte) (entity.Event, error) {
+	event, err := entity.ParseEvent(body)
 	if err != nil {
-		return nil, fmt.Errorf("parse event failed: %w", err)
+		return nil, fmt.Errorf("parse event: %w", err)
 	}
 
 	return event, nil
@@ -480,6 +361,24 @@ func (s *HTTPServer) checkAccess(r *http.Request) *accessError {
 	return nil
 }
 
+// checkSignature 校验事件上报的 X-Signature，未配置 Secret 时不校验.
+func (s *HTTPServer) checkSignature(r *http.Request, body []byte) *accessError {
+	if s.cfg.Secret == "" {
+		return nil
+	}
+
+	signature := r.Header.Get(util.SignatureHeader)
+	if signature == "" {
+		return &accessError{message: "missing signature", code: http.StatusForbidden}
+	}
+
+	if !util.VerifySignature(s.cfg.Secret, body, signature) {
+		return &accessError{message: "invalid signature", code: http.StatusForbidden}
+	}
+
+	return nil
+}
+
 func (s *HTTPServer) parseParams(r *http.Request) (map[string]any, error) {
 	params := make(map[string]any)
 
@@ -540,7 +439,7 @@ func (s *HTTPServer) writeError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, dispatcher.ErrActionNotFound):
 		http.NotFound(w, nil)
-	case errors.Is(err, ErrBadRequest):
+	case errors.Is(err, ErrBadRequest), errors.Is(err, dispatcher.ErrInvalidQuickOperation):
 		http.Error(w, err.Error(), http.StatusBadRequest)
 	default:
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -10,196 +10,295 @@ import (
 )
 
 type MessageEventService interface {
-	HandlePrivateMessage(ctx context.Context, ev *entity.PrivateMessageEvent) (map[string]any, error)
-	HandleGroupMessage(ctx context.Context, ev *entity.GroupMessageEvent) (map[string]any, error)
+	HandlePrivateMessage(ctx context.Context, ev *entity.PrivateMessageEvent) (*entity.MessageQuickOperation, error)
+	HandleGroupMessage(ctx context.Context, ev *entity.GroupMessageEvent) (*entity.MessageQuickOperation, error)
+}
+
+type MessageSentEventService interface {
+	HandlePrivateMessageSent(ctx context.Context, ev *entity.PrivateMessageSentEvent) error
+	HandleGroupMessageSent(ctx context.Context, ev *entity.GroupMessageSentEvent) error
 }
 
 type NoticeEventService interface {
-	HandleGroupFileUpload(ctx context.Context, ev *entity.GroupFileUploadEvent) (map[string]any, error)
-	HandleGroupAdminSet(ctx context.Context, ev *entity.GroupAdminChangeEvent) (map[string]any, error)
-	HandleGroupAdminUnset(ctx context.Context, ev *entity.GroupAdminChangeEvent) (map[string]any, error)
-	HandleGroupMemberDecreaseLeave(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error)
-	HandleGroupMemberDecreaseKick(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error)
-	HandleGroupMemberDecreaseKickMe(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error)
-	HandleGroupMemberIncreaseApprove(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) (map[string]any, error)
-	HandleGroupMemberIncreaseInvite(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) (map[string]any, error)
-	HandleGroupBan(ctx context.Context, ev *entity.GroupBanEvent) (map[string]any, error)
-	HandleFriendAdd(ctx context.Context, ev *entity.FriendAddEvent) (map[string]any, error)
-	HandleGroupRecall(ctx context.Context, ev *entity.GroupRecallEvent) (map[string]any, error)
-	HandleFriendRecall(ctx context.Context, ev *entity.FriendRecallEvent) (map[string]any, error)
-	HandleGroupPoke(ctx context.Context, ev *entity.GroupPokeEvent) (map[string]any, error)
-	HandleGroupLuckyKing(ctx context.Context, ev *entity.GroupLuckyKingEvent) (map[string]any, error)
-	HandleGroupHonorChange(ctx context.Context, ev *entity.GroupHonorChangeEvent) (map[string]any, error)
+	HandleGroupFileUpload(ctx context.Context, ev *entity.GroupFileUploadEvent) error
+	HandleGroupAdminSet(ctx context.Context, ev *entity.GroupAdminChangeEvent) error
+	HandleGroupAdminUnset(ctx context.Context, ev *entity.GroupAdminChangeEvent) error
+	HandleGroupMemberDecreaseLeave(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error
+	HandleGroupMemberDecreaseKick(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error
+	HandleGroupMemberDecreaseKickMe(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error
+	HandleGroupMemberIncreaseApprove(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) error
+	HandleGroupMemberIncreaseInvite(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) error
+	HandleGroupBan(ctx context.Context, ev *entity.GroupBanEvent) error
+	HandleFriendAdd(ctx context.Context, ev *entity.FriendAddEvent) error
+	HandleGroupRecall(ctx context.Context, ev *entity.GroupRecallEvent) error
+	HandleFriendRecall(ctx context.Context, ev *entity.FriendRecallEvent) error
+	HandleGroupPoke(ctx context.Context, ev *entity.GroupPokeEvent) error
+	HandleGroupLuckyKing(ctx context.Context, ev *entity.GroupLuckyKingEvent) error
+	HandleGroupHonorChange(ctx context.Context, ev *entity.GroupHonorChangeEvent) error
+}
+
+type ExtNoticeEventService interface {
+	HandleGroupCard(ctx context.Context, ev *entity.GroupCardEvent) error
+	HandleOfflineFile(ctx context.Context, ev *entity.OfflineFileEvent) error
+	HandleClientStatus(ctx context.Context, ev *entity.ClientStatusEvent) error
+	HandleEssenceAdd(ctx context.Context, ev *entity.EssenceEvent) error
+	HandleEssenceDelete(ctx context.Context, ev *entity.EssenceEvent) error
+	HandleGroupMsgEmojiLike(ctx context.Context, ev *entity.GroupMsgEmojiLikeEvent) error
+	HandleInputStatus(ctx context.Context, ev *entity.InputStatusEvent) error
 }
 
 type RequestEventService interface {
-	HandleFriendRequest(ctx context.Context, ev *entity.FriendRequestEvent) (map[string]any, error)
-	HandleGroupRequestAdd(ctx context.Context, ev *entity.GroupRequestEvent) (map[string]any, error)
-	HandleGroupRequestInvite(ctx context.Context, ev *entity.GroupRequestEvent) (map[string]any, error)
+	HandleFriendRequest(ctx context.Context, ev *entity.FriendRequestEvent) (*entity.FriendRequestQuickOperation, error)
+	HandleGroupRequestAdd(ctx context.Context, ev *entity.GroupRequestEvent) (*entity.GroupRequestQuickOperation, error)
+	HandleGroupRequestInvite(ctx context.Context, ev *entity.GroupRequestEvent) (*entity.GroupRequestQuickOperation, error)
 }
 
 type MetaEventService interface {
-	HandleLifecycle(ctx context.Context, ev *entity.LifecycleEvent) (map[string]any, error)
-	HandleHeartbeat(ctx context.Context, ev *entity.HeartbeatEvent) (map[string]any, error)
+	HandleLifecycle(ctx context.Context, ev *entity.LifecycleEvent) error
+	HandleHeartbeat(ctx context.Context, ev *entity.HeartbeatEvent) error
 }
 
 type OneBotEventService interface {
 	MessageEventService
+	MessageSentEventService
 	NoticeEventService
+	ExtNoticeEventService
 	RequestEventService
 	MetaEventService
 }
 
 func RegisterGeneratedEvents(d *EventDispatcher, svc OneBotEventService) {
-	d.Register("message/private", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandlePrivateMessage(ctx, ev.(*entity.PrivateMessageEvent))
+	d.Register("message/private", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := svc.HandlePrivateMessage(ctx, ev.(*entity.PrivateMessageEvent))
+		if quickOp == nil {
+			return nil, err
+		}
+		return quickOp, err
+	})
+	d.Register("message/group", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := svc.HandleGroupMessage(ctx, ev.(*entity.GroupMessageEvent))
+		if quickOp == nil {
+			return nil, err
+		}
+		return quickOp, err
+	})
+	d.Register("message_sent/private", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandlePrivateMessageSent(ctx, ev.(*entity.PrivateMessageSentEvent))
+	})
+	d.Register("message_sent/group", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMessageSent(ctx, ev.(*entity.GroupMessageSentEvent))
+	})
+	d.Register("notice/group_upload", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupFileUpload(ctx, ev.(*entity.GroupFileUploadEvent))
+	})
+	d.Register("notice/group_admin/set", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupAdminSet(ctx, ev.(*entity.GroupAdminChangeEvent))
 	})
-	d.Register("message/group", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMessage(ctx, ev.(*entity.GroupMessageEvent))
+	d.Register("notice/group_admin/unset", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupAdminUnset(ctx, ev.(*entity.GroupAdminChangeEvent))
 	})
-	d.Register("notice/group_upload", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupFileUpload(ctx, ev.(*entity.GroupFileUploadEvent))
+	d.Register("notice/group_decrease/leave", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMemberDecreaseLeave(ctx, ev.(*entity.GroupMemberDecreaseEvent))
 	})
-	d.Register("notice/group_admin/set", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupAdminSet(ctx, ev.(*entity.GroupAdminChangeEvent))
+	d.Register("notice/group_decrease/kick", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMemberDecreaseKick(ctx, ev.(*entity.GroupMemberDecreaseEvent))
 	})
-	d.Register("notice/group_admin/unset", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupAdminUnset(ctx, ev.(*entity.GroupAdminChangeEvent))
+	d.Register("notice/group_decrease/kick_me", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMemberDecreaseKickMe(ctx, ev.(*entity.GroupMemberDecreaseEvent))
 	})
-	d.Register("notice/group_decrease/leave", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMemberDecreaseLeave(ctx, ev.(*entity.GroupMemberDecreaseEvent))
+	d.Register("notice/group_increase/approve", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMemberIncreaseApprove(ctx, ev.(*entity.GroupMemberIncreaseEvent))
 	})
-	d.Register("notice/group_decrease/kick", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMemberDecreaseKick(ctx, ev.(*entity.GroupMemberDecreaseEvent))
+	d.Register("notice/group_increase/invite", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMemberIncreaseInvite(ctx, ev.(*entity.GroupMemberIncreaseEvent))
 	})
-	d.Register("notice/group_decrease/kick_me", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMemberDecreaseKickMe(ctx, ev.(*entity.GroupMemberDecreaseEvent))
+	d.Register("notice/group_ban", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupBan(ctx, ev.(*entity.GroupBanEvent))
 	})
-	d.Register("notice/group_increase/approve", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMemberIncreaseApprove(ctx, ev.(*entity.GroupMemberIncreaseEvent))
+	d.Register("notice/friend_add", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleFriendAdd(ctx, ev.(*entity.FriendAddEvent))
 	})
-	d.Register("notice/group_increase/invite", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupMemberIncreaseInvite(ctx, ev.(*entity.GroupMemberIncreaseEvent))
+	d.Register("notice/group_recall", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupRecall(ctx, ev.(*entity.GroupRecallEvent))
 	})
-	d.Register("notice/group_ban", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupBan(ctx, ev.(*entity.GroupBanEvent))
+	d.Register("notice/friend_recall", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleFriendRecall(ctx, ev.(*entity.FriendRecallEvent))
 	})
-	d.Register("notice/friend_add", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleFriendAdd(ctx, ev.(*entity.FriendAddEvent))
+	d.Register("notice/notify/poke", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupPoke(ctx, ev.(*entity.GroupPokeEvent))
 	})
-	d.Register("notice/group_recall", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupRecall(ctx, ev.(*entity.GroupRecallEvent))
+	d.Register("notice/notify/lucky_king", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupLuckyKing(ctx, ev.(*entity.GroupLuckyKingEvent))
 	})
-	d.Register("notice/friend_recall", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleFriendRecall(ctx, ev.(*entity.FriendRecallEvent))
+	d.Register("notice/notify/honor", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupHonorChange(ctx, ev.(*entity.GroupHonorChangeEvent))
 	})
-	d.Register("notice/notify/poke", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupPoke(ctx, ev.(*entity.GroupPokeEvent))
+	d.Register("notice/group_card", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupCard(ctx, ev.(*entity.GroupCardEvent))
 	})
-	d.Register("notice/notify/lucky_king", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupLuckyKing(ctx, ev.(*entity.GroupLuckyKingEvent))
+	d.Register("notice/offline_file", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleOfflineFile(ctx, ev.(*entity.OfflineFileEvent))
 	})
-	d.Register("notice/notify/honor", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupHonorChange(ctx, ev.(*entity.GroupHonorChangeEvent))
+	d.Register("notice/client_status", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleClientStatus(ctx, ev.(*entity.ClientStatusEvent))
 	})
-	d.Register("request/friend", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleFriendRequest(ctx, ev.(*entity.FriendRequestEvent))
+	d.Register("notice/essence/add", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleEssenceAdd(ctx, ev.(*entity.EssenceEvent))
 	})
-	d.Register("request/group/add", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupRequestAdd(ctx, ev.(*entity.GroupRequestEvent))
+	d.Register("notice/essence/delete", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleEssenceDelete(ctx, ev.(*entity.EssenceEvent))
 	})
-	d.Register("request/group/invite", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleGroupRequestInvite(ctx, ev.(*entity.GroupRequestEvent))
+	d.Register("notice/group_msg_emoji_like", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleGroupMsgEmojiLike(ctx, ev.(*entity.GroupMsgEmojiLikeEvent))
 	})
-	d.Register("meta_event/lifecycle", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleLifecycle(ctx, ev.(*entity.LifecycleEvent))
+	d.Register("notice/notify/input_status", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleInputStatus(ctx, ev.(*entity.InputStatusEvent))
 	})
-	d.Register("meta_event/heartbeat", func(ctx context.Context, ev entity.Event) (map[string]any, error) {
-		return svc.HandleHeartbeat(ctx, ev.(*entity.HeartbeatEvent))
+	d.Register("request/friend", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := svc.HandleFriendRequest(ctx, ev.(*entity.FriendRequestEvent))
+		if quickOp == nil {
+			return nil, err
+		}
+		return quickOp, err
+	})
+	d.Register("request/group/add", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := svc.HandleGroupRequestAdd(ctx, ev.(*entity.GroupRequestEvent))
+		if quickOp == nil {
+			return nil, err
+		}
+		return quickOp, err
+	})
+	d.Register("request/group/invite", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := svc.HandleGroupRequestInvite(ctx, ev.(*entity.GroupRequestEvent))
+		if quickOp == nil {
+			return nil, err
+		}
+		return quickOp, err
+	})
+	d.Register("meta_event/lifecycle", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleLifecycle(ctx, ev.(*entity.LifecycleEvent))
+	})
+	d.Register("meta_event/heartbeat", func(ctx context.Context, ev entity.Event) (entity.QuickOperation, error) {
+		return nil, svc.HandleHeartbeat(ctx, ev.(*entity.HeartbeatEvent))
 	})
 }
 
 type UnimplementedOneBotEventService struct {
 	UnimplementedMessageEventService
+	UnimplementedMessageSentEventService
 	UnimplementedNoticeEventService
+	UnimplementedExtNoticeEventService
 	UnimplementedRequestEventService
 	UnimplementedMetaEventService
 }
 
 type UnimplementedMessageEventService struct{}
 
-func (*UnimplementedMessageEventService) HandlePrivateMessage(ctx context.Context, ev *entity.PrivateMessageEvent) (map[string]any, error) {
+func (*UnimplementedMessageEventService) HandlePrivateMessage(ctx context.Context, ev *entity.PrivateMessageEvent) (*entity.MessageQuickOperation, error) {
 	panic("unimplemented")
 }
-func (*UnimplementedMessageEventService) HandleGroupMessage(ctx context.Context, ev *entity.GroupMessageEvent) (map[string]any, error) {
+func (*UnimplementedMessageEventService) HandleGroupMessage(ctx context.Context, ev *entity.GroupMessageEvent) (*entity.MessageQuickOperation, error) {
+	panic("unimplemented")
+}
+
+type UnimplementedMessageSentEventService struct{}
+
+func (*UnimplementedMessageSentEventService) HandlePrivateMessageSent(ctx context.Context, ev *entity.PrivateMessageSentEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedMessageSentEventService) HandleGroupMessageSent(ctx context.Context, ev *entity.GroupMessageSentEvent) error {
 	panic("unimplemented")
 }
 
 type UnimplementedNoticeEventService struct{}
 
-func (*UnimplementedNoticeEventService) HandleGroupFileUpload(ctx context.Context, ev *entity.GroupFileUploadEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupFileUpload(ctx context.Context, ev *entity.GroupFileUploadEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupAdminSet(ctx context.Context, ev *entity.GroupAdminChangeEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupAdminUnset(ctx context.Context, ev *entity.GroupAdminChangeEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseLeave(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseKick(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupAdminSet(ctx context.Context, ev *entity.GroupAdminChangeEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseKickMe(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupAdminUnset(ctx context.Context, ev *entity.GroupAdminChangeEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupMemberIncreaseApprove(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseLeave(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupMemberIncreaseInvite(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseKick(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupBan(ctx context.Context, ev *entity.GroupBanEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupMemberDecreaseKickMe(ctx context.Context, ev *entity.GroupMemberDecreaseEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleFriendAdd(ctx context.Context, ev *entity.FriendAddEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupMemberIncreaseApprove(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupRecall(ctx context.Context, ev *entity.GroupRecallEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupMemberIncreaseInvite(ctx context.Context, ev *entity.GroupMemberIncreaseEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleFriendRecall(ctx context.Context, ev *entity.FriendRecallEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupBan(ctx context.Context, ev *entity.GroupBanEvent) (map[string]any, error) {
+func (*UnimplementedNoticeEventService) HandleGroupPoke(ctx context.Context, ev *entity.GroupPokeEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupLuckyKing(ctx context.Context, ev *entity.GroupLuckyKingEvent) error {
+	panic("unimplemented")
+}
+func (*UnimplementedNoticeEventService) HandleGroupHonorChange(ctx context.Context, ev *entity.GroupHonorChangeEvent) error {
+	panic("unimplemented")
+}
+
+type UnimplementedExtNoticeEventService struct{}
+
+func (*UnimplementedExtNoticeEventService) HandleGroupCard(ctx context.Context, ev *entity.GroupCardEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleFriendAdd(ctx context.Context, ev *entity.FriendAddEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleOfflineFile(ctx context.Context, ev *entity.OfflineFileEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupRecall(ctx context.Context, ev *entity.GroupRecallEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleClientStatus(ctx context.Context, ev *entity.ClientStatusEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleFriendRecall(ctx context.Context, ev *entity.FriendRecallEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleEssenceAdd(ctx context.Context, ev *entity.EssenceEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupPoke(ctx context.Context, ev *entity.GroupPokeEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleEssenceDelete(ctx context.Context, ev *entity.EssenceEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupLuckyKing(ctx context.Context, ev *entity.GroupLuckyKingEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleGroupMsgEmojiLike(ctx context.Context, ev *entity.GroupMsgEmojiLikeEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedNoticeEventService) HandleGroupHonorChange(ctx context.Context, ev *entity.GroupHonorChangeEvent) (map[string]any, error) {
+func (*UnimplementedExtNoticeEventService) HandleInputStatus(ctx context.Context, ev *entity.InputStatusEvent) error {
 	panic("unimplemented")
 }
 
 type UnimplementedRequestEventService struct{}
 
-func (*UnimplementedRequestEventService) HandleFriendRequest(ctx context.Context, ev *entity.FriendRequestEvent) (map[string]any, error) {
+func (*UnimplementedRequestEventService) HandleFriendRequest(ctx context.Context, ev *entity.FriendRequestEvent) (*entity.FriendRequestQuickOperation, error) {
 	panic("unimplemented")
 }
-func (*UnimplementedRequestEventService) HandleGroupRequestAdd(ctx context.Context, ev *entity.GroupRequestEvent) (map[string]any, error) {
+func (*UnimplementedRequestEventService) HandleGroupRequestAdd(ctx context.Context, ev *entity.GroupRequestEvent) (*entity.GroupRequestQuickOperation, error) {
 	panic("unimplemented")
 }
-func (*UnimplementedRequestEventService) HandleGroupRequestInvite(ctx context.Context, ev *entity.GroupRequestEvent) (map[string]any, error) {
+func (*UnimplementedRequestEventService) HandleGroupRequestInvite(ctx context.Context, ev *entity.GroupRequestEvent) (*entity.GroupRequestQuickOperation, error) {
 	panic("unimplemented")
 }
 
 type UnimplementedMetaEventService struct{}
 
-func (*UnimplementedMetaEventService) HandleLifecycle(ctx context.Context, ev *entity.LifecycleEvent) (map[string]any, error) {
+func (*UnimplementedMetaEventService) HandleLifecycle(ctx context.Context, ev *entity.LifecycleEvent) error {
 	panic("unimplemented")
 }
-func (*UnimplementedMetaEventService) HandleHeartbeat(ctx context.Context, ev *entity.HeartbeatEvent) (map[string]any, error) {
+func (*UnimplementedMetaEventService) HandleHeartbeat(ctx context.Context, ev *entity.HeartbeatEvent) error {
 	panic("unimplemented")
 }
@@ -0,0 +1,300 @@
+// Code generated by bindings-gen. DO NOT EDIT.
+// Source: cmd/bindings-gen/config_ext.yaml
+
+package server
+
+import (
+	"context"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+)
+
+// ExtMessageService 扩展消息服务
+type ExtMessageService interface {
+	// SendGroupForwardMsg 发送群合并转发消息.
+	SendGroupForwardMsg(ctx context.Context, req *entity.SendGroupForwardMsgRequest) (*entity.ActionResponse[entity.SendGroupForwardMsgResponse], error)
+	// SendPrivateForwardMsg 发送私聊合并转发消息.
+	SendPrivateForwardMsg(ctx context.Context, req *entity.SendPrivateForwardMsgRequest) (*entity.ActionResponse[entity.SendPrivateForwardMsgResponse], error)
+	// GetGroupMsgHistory 获取群消息历史记录.
+	GetGroupMsgHistory(ctx context.Context, req *entity.GetGroupMsgHistoryRequest) (*entity.ActionResponse[entity.GetGroupMsgHistoryResponse], error)
+	// MarkMsgAsRead 标记消息已读.
+	MarkMsgAsRead(ctx context.Context, req *entity.MarkMsgAsReadRequest) (*entity.ActionResponse[entity.MarkMsgAsReadResponse], error)
+	// SetMsgEmojiLike 对消息贴表情回应.
+	SetMsgEmojiLike(ctx context.Context, req *entity.SetMsgEmojiLikeRequest) (*entity.ActionResponse[entity.SetMsgEmojiLikeResponse], error)
+}
+
+// ExtGroupService 扩展群管理
+type ExtGroupService interface {
+	// SetEssenceMsg 设置精华消息.
+	SetEssenceMsg(ctx context.Context, req *entity.SetEssenceMsgRequest) (*entity.ActionResponse[entity.SetEssenceMsgResponse], error)
+	// DeleteEssenceMsg 移出精华消息.
+	DeleteEssenceMsg(ctx context.Context, req *entity.DeleteEssenceMsgRequest) (*entity.ActionResponse[entity.DeleteEssenceMsgResponse], error)
+	// GetEssenceMsgList 获取精华消息列表.
+	GetEssenceMsgList(ctx context.Context, req *entity.GetEssenceMsgListRequest) (*entity.ActionResponse[entity.GetEssenceMsgListResponse], error)
+	// SendGroupNotice 发送群公告.
+	SendGroupNotice(ctx context.Context, req *entity.SendGroupNoticeRequest) (*entity.ActionResponse[entity.SendGroupNoticeResponse], error)
+	// GetGroupAtAllRemain 获取群 @全体成员 剩余次数.
+	GetGroupAtAllRemain(ctx context.Context, req *entity.GetGroupAtAllRemainRequest) (*entity.ActionResponse[entity.GetGroupAtAllRemainResponse], error)
+}
+
+// ExtFileService 扩展群文件
+type ExtFileService interface {
+	// UploadGroupFile 上传群文件.
+	UploadGroupFile(ctx context.Context, req *entity.UploadGroupFileRequest) (*entity.ActionResponse[entity.UploadGroupFileResponse], error)
+	// UploadPrivateFile 上传私聊文件.
+	UploadPrivateFile(ctx context.Context, req *entity.UploadPrivateFileRequest) (*entity.ActionResponse[entity.UploadPrivateFileResponse], error)
+	// GetGroupFileUrl 获取群文件资源链接.
+	GetGroupFileUrl(ctx context.Context, req *entity.GetGroupFileUrlRequest) (*entity.ActionResponse[entity.GetGroupFileUrlResponse], error)
+	// GetGroupRootFiles 获取群根目录文件列表.
+	GetGroupRootFiles(ctx context.Context, req *entity.GetGroupRootFilesRequest) (*entity.ActionResponse[entity.GetGroupRootFilesResponse], error)
+	// GetGroupFilesByFolder 获取群子目录文件列表.
+	GetGroupFilesByFolder(ctx context.Context, req *entity.GetGroupFilesByFolderRequest) (*entity.ActionResponse[entity.GetGroupFilesByFolderResponse], error)
+	// DeleteGroupFile 删除群文件.
+	DeleteGroupFile(ctx context.Context, req *entity.DeleteGroupFileRequest) (*entity.ActionResponse[entity.DeleteGroupFileResponse], error)
+	// CreateGroupFileFolder 创建群文件夹.
+	CreateGroupFileFolder(ctx context.Context, req *entity.CreateGroupFileFolderRequest) (*entity.ActionResponse[entity.CreateGroupFileFolderResponse], error)
+}
+
+// ExtMiscService 扩展工具
+type ExtMiscService interface {
+	// OcrImage 图片 OCR.
+	OcrImage(ctx context.Context, req *entity.OcrImageRequest) (*entity.ActionResponse[entity.OcrImageResponse], error)
+	// GetOnlineClients 获取当前账号在线客户端列表.
+	GetOnlineClients(ctx context.Context, req *entity.GetOnlineClientsRequest) (*entity.ActionResponse[entity.GetOnlineClientsResponse], error)
+	// CheckUrlSafely 检查链接安全性.
+	CheckUrlSafely(ctx context.Context, req *entity.CheckUrlSafelyRequest) (*entity.ActionResponse[entity.CheckUrlSafelyResponse], error)
+}
+
+// OneBotExtensionService aggregates all service groups.
+type OneBotExtensionService interface {
+	// 扩展消息服务.
+	ExtMessageService
+	// 扩展群管理.
+	ExtGroupService
+	// 扩展群文件.
+	ExtFileService
+	// 扩展工具.
+	ExtMiscService
+}
+
+// RegisterGeneratedExtension registers actions to dispatcher.
+func RegisterGeneratedExtension(d *dispatcher.Dispatcher, svc OneBotExtensionService) {
+	// Group: ext_message
+	d.Register("send_group_forward_msg", dispatcher.APIFuncToActionHandler(svc.SendGroupForwardMsg))
+	d.Register("send_private_forward_msg", dispatcher.APIFuncToActionHandler(svc.SendPrivateForwardMsg))
+	d.Register("get_group_msg_history", dispatcher.APIFuncToActionHandler(svc.GetGroupMsgHistory))
+	d.Register("mark_msg_as_read", dispatcher.APIFuncToActionHandler(svc.MarkMsgAsRead))
+	d.Register("set_msg_emoji_like", dispatcher.APIFuncToActionHandler(svc.SetMsgEmojiLike))
+
+	// Group: ext_group
+	d.Register("set_essence_msg", dispatcher.APIFuncToActionHandler(svc.SetEssenceMsg))
+	d.Register("delete_essence_msg", dispatcher.APIFuncToActionHandler(svc.DeleteEssenceMsg))
+	d.Register("get_essence_msg_list", dispatcher.APIFuncToActionHandler(svc.GetEssenceMsgList))
+	d.Register("_send_group_notice", dispatcher.APIFuncToActionHandler(svc.SendGroupNotice))
+	d.Register("get_group_at_all_remain", dispatcher.APIFuncToActionHandler(svc.GetGroupAtAllRemain))
+
+	// Group: ext_file
+	d.Register("upload_group_file", dispatcher.APIFuncToActionHandler(svc.UploadGroupFile))
+	d.Register("upload_private_file", dispatcher.APIFuncToActionHandler(svc.UploadPrivateFile))
+	d.Register("get_group_file_url", dispatcher.APIFuncToActionHandler(svc.GetGroupFileUrl))
+	d.Register("get_group_root_files", dispatcher.APIFuncToActionHandler(svc.GetGroupRootFiles))
+	d.Register("get_group_files_by_folder", dispatcher.APIFuncToActionHandler(svc.GetGroupFilesByFolder))
+	d.Register("delete_group_file", dispatcher.APIFuncToActionHandler(svc.DeleteGroupFile))
+	d.Register("create_group_file_folder", dispatcher.APIFuncToActionHandler(svc.CreateGroupFileFolder))
+
+	// Group: ext_misc
+	d.Register("ocr_image", dispatcher.APIFuncToActionHandler(svc.OcrImage))
+	d.Register("get_online_clients", dispatcher.APIFuncToActionHandler(svc.GetOnlineClients))
+	d.Register("check_url_safely", dispatcher.APIFuncToActionHandler(svc.CheckUrlSafely))
+
+}
+
+// UnimplementedOneBotExtensionService aggregates unimplemented group services.
+type UnimplementedOneBotExtensionService struct {
+	UnimplementedExtMessageService
+	UnimplementedExtGroupService
+	UnimplementedExtFileService
+	UnimplementedExtMiscService
+}
+
+// UnimplementedExtMessageService provides default empty implementations.
+type UnimplementedExtMessageService struct{}
+
+// SendGroupForwardMsg 发送群合并转发消息 (unimplemented).
+func (*UnimplementedExtMessageService) SendGroupForwardMsg(
+	ctx context.Context,
+	req *entity.SendGroupForwardMsgRequest,
+) (*entity.ActionResponse[entity.SendGroupForwardMsgResponse], error) {
+	panic("unimplemented")
+}
+
+// SendPrivateForwardMsg 发送私聊合并转发消息 (unimplemented).
+func (*UnimplementedExtMessageService) SendPrivateForwardMsg(
+	ctx context.Context,
+	req *entity.SendPrivateForwardMsgRequest,
+) (*entity.ActionResponse[entity.SendPrivateForwardMsgResponse], error) {
+	panic("unimplemented")
+}
+
+// GetGroupMsgHistory 获取群消息历史记录 (unimplemented).
+func (*UnimplementedExtMessageService) GetGroupMsgHistory(
+	ctx context.Context,
+	req *entity.GetGroupMsgHistoryRequest,
+) (*entity.ActionResponse[entity.GetGroupMsgHistoryResponse], error) {
+	panic("unimplemented")
+}
+
+// MarkMsgAsRead 标记消息已读 (unimplemented).
+func (*UnimplementedExtMessageService) MarkMsgAsRead(
+	ctx context.Context,
+	req *entity.MarkMsgAsReadRequest,
+) (*entity.ActionResponse[entity.MarkMsgAsReadResponse], error) {
+	panic("unimplemented")
+}
+
+// SetMsgEmojiLike 对消息贴表情回应 (unimplemented).
+func (*UnimplementedExtMessageService) SetMsgEmojiLike(
+	ctx context.Context,
+	req *entity.SetMsgEmojiLikeRequest,
+) (*entity.ActionResponse[entity.SetMsgEmojiLikeResponse], error) {
+	panic("unimplemented")
+}
+
+// UnimplementedExtGroupService provides default empty implementations.
+type UnimplementedExtGroupService struct{}
+
+// SetEssenceMsg 设置精华消息 (unimplemented).
+func (*UnimplementedExtGroupService) SetEssenceMsg(
+	ctx context.Context,
+	req *entity.SetEssenceMsgRequest,
+) (*entity.ActionResponse[entity.SetEssenceMsgResponse], error) {
+	panic("unimplemented")
+}
+
+// DeleteEssenceMsg 移出精华消息 (unimplemented).
+func (*UnimplementedExtGroupService) DeleteEssenceMsg(
+	ctx context.Context,
+	req *entity.DeleteEssenceMsgRequest,
+) (*entity.ActionResponse[entity.DeleteEssenceMsgResponse], error) {
+	panic("unimplemented")
+}
+
+// GetEssenceMsgList 获取精华消息列表 (unimplemented).
+func (*UnimplementedExtGroupService) GetEssenceMsgList(
+	ctx context.Context,
+	req *entity.GetEssenceMsgListRequest,
+) (*entity.ActionResponse[entity.GetEssenceMsgListResponse], error) {
+	panic("unimplemented")
+}
+
+// SendGroupNotice 发送群公告 (unimplemented).
+func (*UnimplementedExtGroupService) SendGroupNotice(
+	ctx context.Context,
+	req *entity.SendGroupNoticeRequest,
+) (*entity.ActionResponse[entity.SendGroupNoticeResponse], error) {
+	panic("unimplemented")
+}
+
+// GetGroupAtAllRemain 获取群 @全体成员 剩余次数 (unimplemented).
+func (*UnimplementedExtGroupService) GetGroupAtAllRemain(
+	ctx context.Context,
+	req *entity.GetGroupAtAllRemainRequest,
+) (*entity.ActionResponse[entity.GetGroupAtAllRemainResponse], error) {
+	panic("unimplemented")
+}
+
+// UnimplementedExtFileService provides default empty implementations.
+type UnimplementedExtFileService struct{}
+
+// UploadGroupFile 上传群文件 (unimplemented).
+func (*UnimplementedExtFileService) UploadGroupFile(
+	ctx context.Context,
+	req *entity.UploadGroupFileRequest,
+) (*entity.ActionResponse[entity.UploadGroupFileResponse], error) {
+	panic("unimplemented")
+}
+
+// UploadPrivateFile 上传私聊文件 (unimplemented).
+func (*UnimplementedExtFileService) UploadPrivateFile(
+	ctx context.Context,
+	req *entity.UploadPrivateFileRequest,
+) (*entity.ActionResponse[entity.UploadPrivateFileResponse], error) {
+	panic("unimplemented")
+}
+
+// GetGroupFileUrl 获取群文件资源链接 (unimplemented).
+func (*UnimplementedExtFileService) GetGroupFileUrl(
+	ctx context.Context,
+	req *entity.GetGroupFileUrlRequest,
+) (*entity.ActionResponse[entity.GetGroupFileUrlResponse], error) {
+	panic("unimplemented")
+}
+
+// GetGroupRootFiles 获取群根目录文件列表 (unimplemented).
+func (*UnimplementedExtFileService) GetGroupRootFiles(
+	ctx context.Context,
+	req *entity.GetGroupRootFilesRequest,
+) (*entity.ActionResponse[entity.GetGroupRootFilesResponse], error) {
+	panic("unimplemented")
+}
+
+// GetGroupFilesByFolder 获取群子目录文件列表 (unimplemented).
+func (*UnimplementedExtFileService) GetGroupFilesByFolder(
+	ctx context.Context,
+	req *entity.GetGroupFilesByFolderRequest,
+) (*entity.ActionResponse[entity.GetGroupFilesByFolderResponse], error) {
+	panic("unimplemented")
+}
+
+// DeleteGroupFile 删除群文件 (unimplemented).
+func (*UnimplementedExtFileService) DeleteGroupFile(
+	ctx context.Context,
+	req *entity.DeleteGroupFileRequest,
+) (*entity.ActionResponse[entity.DeleteGroupFileResponse], error) {
+	panic("unimplemented")
+}
+
+// CreateGroupFileFolder 创建群文件夹 (unimplemented).
+func (*UnimplementedExtFileService) CreateGroupFileFolder(
+	ctx context.Context,
+	req *entity.CreateGroupFileFolderRequest,
+) (*entity.ActionResponse[entity.CreateGroupFileFolderResponse], error) {
+	panic("unimplemented")
+}
+
+// UnimplementedExtMiscService provides default empty implementations.
+type UnimplementedExtMiscService struct{}
+
+// OcrImage 图片 OCR (unimplemented).
+func (*UnimplementedExtMiscService) OcrImage(
+	ctx context.Context,
+	req *entity.OcrImageRequest,
+) (*entity.ActionResponse[entity.OcrImageResponse], error) {
+	panic("unimplemented")
+}
+
+// GetOnlineClients 获取当前账号在线客户端列表 (unimplemented).
+func (*UnimplementedExtMiscService) GetOnlineClients(
+	ctx context.Context,
+	req *entity.GetOnlineClientsRequest,
+) (*entity.ActionResponse[entity.GetOnlineClientsResponse], error) {
+	panic("unimplemented")
+}
+
+// CheckUrlSafely 检查链接安全性 (unimplemented).
+func (*UnimplementedExtMiscService) CheckUrlSafely(
+	ctx context.Context,
+	req *entity.CheckUrlSafelyRequest,
+) (*entity.ActionResponse[entity.CheckUrlSafelyResponse], error) {
+	panic("unimplemented")
+}
+
+var (
+	_ OneBotExtensionService = (*UnimplementedOneBotExtensionService)(nil)
+
+	_ ExtMessageService = (*UnimplementedExtMessageService)(nil)
+	_ ExtGroupService   = (*UnimplementedExtGroupService)(nil)
+	_ ExtFileService    = (*UnimplementedExtFileService)(nil)
+	_ ExtMiscService    = (*UnimplementedExtMiscService)(nil)
+)
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+type essenceExtensionService struct {
+	UnimplementedOneBotExtensionService
+}
+
+func (*essenceExtensionService) SetEssenceMsg(
+	_ context.Context,
+	req *entity.SetEssenceMsgRequest,
+) (*entity.ActionResponse[entity.SetEssenceMsgResponse], error) {
+	if req.MessageId != 42 {
+		return &entity.ActionResponse[entity.SetEssenceMsgResponse]{
+			Status:  entity.StatusFailed,
+			Retcode: entity.RetcodeInvalidParams,
+		}, nil
+	}
+
+	return &entity.ActionResponse[entity.SetEssenceMsgResponse]{
+		Status: entity.StatusOK,
+		Data:   &entity.SetEssenceMsgResponse{},
+	}, nil
+}
+
+func TestRegisterGeneratedExtension(t *testing.T) {
+	t.Parallel()
+
+	d := dispatcher.NewDispatcher()
+	RegisterGeneratedExtension(d, &essenceExtensionService{})
+
+	resp, err := d.HandleActionRequest(context.Background(), &entity.ActionRequest{
+		Action: "set_essence_msg",
+		Params: map[string]any{"message_id": 42},
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusOK, resp.Status)
+	require.JSONEq(t, `{}`, string(resp.Data))
+
+	resp, err = d.HandleActionRequest(context.Background(), &entity.ActionRequest{
+		Action: "set_essence_msg",
+		Params: map[string]any{"message_id": json.Number("1")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFailed, resp.Status)
+	require.Equal(t, entity.RetcodeInvalidParams, resp.Retcode)
+
+	// 未注册扩展 API 的 Dispatcher 不受影响
+	_, err = dispatcher.NewDispatcher().HandleActionRequest(context.Background(), &entity.ActionRequest{
+		Action: "set_essence_msg",
+	})
+	require.ErrorIs(t, err, dispatcher.ErrActionNotFound)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
 	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -72,6 +73,7 @@ func TestNewHTTPServer_OptionsApplied(t *testing.T) {
 		WithWriteTimeout(3*time.Second),
 		WithIdleTimeout(4*time.Second),
 		WithAccessToken("token"),
+		WithSecret("secret"),
 	)
 
 	require.Equal(t, ":1234", server.cfg.Addr)
@@ -82,6 +84,7 @@ func TestNewHTTPServer_OptionsApplied(t *testing.T) {
 	require.Equal(t, 3*time.Second, server.cfg.WriteTimeout)
 	require.Equal(t, 4*time.Second, server.cfg.IdleTimeout)
 	require.Equal(t, "token", server.cfg.AccessToken)
+	require.Equal(t, "secret", server.cfg.Secret)
 }
 
 func newTestServer(cfg HTTPConfig, handler dispatcher.ActionRequestHandlerFunc) *HTTPServer {
@@ -368,8 +371,8 @@ func TestHTTPServer_EventPath_Registration(t *testing.T) {
 		Addr:      ":0",
 		EventPath: "/event",
 	}
-	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (map[string]any, error) {
-		return map[string]any{"reply": "test"}, nil
+	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return entity.QuickOperationMap{"reply": "test"}, nil
 	})
 
 	server := NewHTTPServer(WithHTTPConfig(cfg), WithActionHandler(dispatcher.ActionRequestHandlerFunc(
@@ -445,6 +448,51 @@ func TestHTTPServer_EventPath_NoHandler_Returns204(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, recorder.Code)
 }
 
+func TestHTTPServer_EventPath_Signature(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan entity.Event, 1)
+	server := NewHTTPServer(
+		WithEventPath("/event"),
+		WithSecret("secret"),
+		WithEventHandler(EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+			events <- event
+
+			return nil, nil
+		})),
+	)
+
+	body := []byte(reverseWSTestGroupMessage)
+
+	cases := []struct {
+		name      string
+		signature string
+		status    int
+	}{
+		{name: "valid", signature: util.SignBody("secret", body), status: http.StatusNoContent},
+		{name: "missing", signature: "", status: http.StatusForbidden},
+		{name: "wrong secret", signature: util.SignBody("other", body), status: http.StatusForbidden},
+		{name: "tampered", signature: util.SignBody("secret", append(body, ' ')), status: http.StatusForbidden},
+	}
+
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodPost, "/event", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		if tc.signature != "" {
+			req.Header.Set("X-Signature", tc.signature)
+		}
+
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, req)
+		require.Equal(t, tc.status, recorder.Code, tc.name)
+	}
+
+	// 只有签名正确的事件交由处理器处理，且签名校验后事件仍能正常解析
+	require.Len(t, events, 1)
+	require.IsType(t, &entity.GroupMessageEvent{}, <-events)
+}
+
 func TestHTTPServer_EventPath_OnlyAcceptsPOST(t *testing.T) {
 	t.Parallel()
 
@@ -452,7 +500,7 @@ func TestHTTPServer_EventPath_OnlyAcceptsPOST(t *testing.T) {
 		Addr:      ":0",
 		EventPath: "/event",
 	}
-	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作，这是预期的行为
 		return nil, nil
 	})
@@ -477,7 +525,7 @@ func TestHTTPServer_EventPath_InvalidJSON_Returns400(t *testing.T) {
 		Addr:      ":0",
 		EventPath: "/event",
 	}
-	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作，这是预期的行为
 		return nil, nil
 	})
@@ -503,7 +551,7 @@ func TestHTTPServer_EventPath_EmptyQuickOp_Returns204(t *testing.T) {
 		Addr:      ":0",
 		EventPath: "/event",
 	}
-	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	eventHandler := EventRequestHandlerFunc(func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		//nolint:nilnil // 返回 nil，应该返回 204
 		return nil, nil
 	})
@@ -612,17 +660,18 @@ func TestHTTPServer_EventPath_AllEventTypes(t *testing.T) {
 	}
 
 	eventDisp := NewEventDispatcher()
-	eventDisp.Register("message/private", func(_ context.Context, _ entity.Event) (map[string]any, error) {
-		return map[string]any{"reply": "private"}, nil
+	eventDisp.Register("message/private", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return (&entity.MessageQuickOperation{}).SetReply(
+			&entity.MessageValue{Type: entity.MessageValueTypeString, StringValue: "private"}), nil
 	})
-	eventDisp.Register("notice/group_upload", func(_ context.Context, _ entity.Event) (map[string]any, error) {
-		return map[string]any{"reply": "upload"}, nil
+	eventDisp.Register("notice/group_upload", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return entity.QuickOperationMap{"reply": "upload"}, nil
 	})
-	eventDisp.Register("request/friend", func(_ context.Context, _ entity.Event) (map[string]any, error) {
-		return map[string]any{"approve": true}, nil
+	eventDisp.Register("request/friend", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return (&entity.FriendRequestQuickOperation{}).SetApprove(true), nil
 	})
-	eventDisp.Register("meta_event/lifecycle", func(_ context.Context, _ entity.Event) (map[string]any, error) {
-		return map[string]any{}, nil
+	eventDisp.Register("meta_event/lifecycle", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return entity.QuickOperationMap{}, nil
 	})
 
 	server := NewHTTPServer(WithHTTPConfig(cfg), WithActionHandler(dispatcher.ActionRequestHandlerFunc(
@@ -660,19 +709,19 @@ func TestEventDispatcher_Routing(t *testing.T) {
 	// 注册不同级别的处理器
 	var calledKey string
 
-	dispatcher.Register("message", func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	dispatcher.Register("message", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		calledKey = "message"
 
 		//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
 		return nil, nil
 	})
-	dispatcher.Register("message/private", func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	dispatcher.Register("message/private", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		calledKey = "message/private"
 
 		//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
 		return nil, nil
 	})
-	dispatcher.Register("message/private/friend", func(_ context.Context, _ entity.Event) (map[string]any, error) {
+	dispatcher.Register("message/private/friend", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
 		calledKey = "message/private/friend"
 
 		//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
@@ -694,3 +743,290 @@ func TestEventDispatcher_Routing(t *testing.T) {
 
 	assert.Equal(t, "message/private/friend", calledKey)
 }
+
+func TestEventDispatcher_UnknownEventRouting(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := NewEventDispatcher()
+	dispatcher.Register("notice", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return entity.QuickOperationMap{"key": "notice"}, nil
+	})
+	dispatcher.Register("notice/group_name", func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+		unknown, ok := event.(*entity.UnknownEvent)
+		require.True(t, ok)
+
+		var payload struct {
+			NameNew string `json:"name_new"`
+		}
+
+		require.NoError(t, unknown.Decode(&payload))
+
+		return entity.QuickOperationMap{"key": "notice/group_name", "card": payload.NameNew}, nil
+	})
+	dispatcher.Register("self_message/private", func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+		return entity.QuickOperationMap{"key": "self_message/private"}, nil
+	})
+
+	cases := []struct {
+		name    string
+		payload string
+		wantKey string
+		wantErr error
+	}{
+		{
+			name:    "unknown_notice_type",
+			payload: `{"post_type":"notice","notice_type":"group_name","sub_type":"x","name_new":"a"}`,
+			wantKey: "notice/group_name",
+		},
+		{
+			name:    "unknown_notify_sub_type",
+			payload: `{"post_type":"notice","notice_type":"notify","sub_type":"profile_like"}`,
+			wantKey: "notice",
+		},
+		{
+			name:    "unknown_post_type",
+			payload: `{"post_type":"self_message","self_message_type":"private"}`,
+			wantKey: "self_message/private",
+		},
+		{
+			name:    "no_handler",
+			payload: `{"post_type":"unknown"}`,
+			wantErr: ErrNoEventHandler,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, err := entity.ParseEvent([]byte(tc.payload))
+			require.NoError(t, err)
+
+			resp, err := dispatcher.HandleEvent(context.Background(), event)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			respMap, ok := resp.(entity.QuickOperationMap)
+			require.True(t, ok)
+			require.Equal(t, tc.wantKey, respMap["key"])
+		})
+	}
+}
+
+func TestHTTPServer_EventPath_UnknownEventReachesHandler(t *testing.T) {
+	t.Parallel()
+
+	var received entity.Event
+
+	server := NewHTTPServer(WithHTTPConfig(HTTPConfig{EventPath: "/event"}), WithEventHandler(
+		EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+			received = event
+
+			//nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
+			return nil, nil
+		})))
+
+	payload := `{"time":1,"self_id":2,"post_type":"notice","notice_type":"group_name","name_new":"a"}`
+
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/event", bytes.NewBufferString(payload))
+	req.Header.Set("Content-Type", "application/json")
+	server.Handler().ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	unknown, ok := received.(*entity.UnknownEvent)
+	require.True(t, ok)
+	require.Equal(t, "group_name", unknown.GetDetailType())
+	require.JSONEq(t, payload, string(unknown.GetRaw()))
+}
+
+func TestEventDispatcher_MessageSentRouting(t *testing.T) {
+	t.Parallel()
+
+	var calledKey string
+
+	dispatcher := NewEventDispatcher()
+	for _, key := range []string{"message_sent", "message_sent/group", "message_sent/private/friend"} {
+		dispatcher.Register(key, func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+			calledKey = key
+
+			return nil, nil //nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
+		})
+	}
+
+	event, err := entity.ParseEvent([]byte(`{"post_type":"message_sent","message_type":"private","sub_type":"friend"}`))
+	require.NoError(t, err)
+
+	_, err = dispatcher.HandleEvent(context.Background(), event)
+	require.NoError(t, err)
+	assert.Equal(t, "message_sent/private/friend", calledKey)
+
+	_, err = dispatcher.HandleEvent(context.Background(), &entity.GroupMessageSentEvent{
+		PostType: entity.EventPostTypeMessageSent, MessageType: entity.EventMessageTypeGroup,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "message_sent/group", calledKey)
+}
+
+func TestEventDispatcher_IgnoreSelfMessages(t *testing.T) {
+	t.Parallel()
+
+	called := 0
+
+	dispatcher := NewEventDispatcher(WithIgnoreSelfMessages())
+	for _, key := range []string{"message", "message_sent", "notice"} {
+		dispatcher.Register(key, func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+			called++
+
+			return entity.QuickOperationMap{"reply": "loop"}, nil
+		})
+	}
+
+	ignored := []entity.Event{
+		&entity.PrivateMessageSentEvent{PostType: entity.EventPostTypeMessageSent, SelfId: 1, UserId: 1},
+		&entity.GroupMessageSentEvent{PostType: entity.EventPostTypeMessageSent, SelfId: 1, UserId: 1},
+		&entity.PrivateMessageEvent{PostType: entity.EventPostTypeMessage, SelfId: 1, UserId: 1},
+		&entity.GroupMessageEvent{PostType: entity.EventPostTypeMessage, SelfId: 1, UserId: 1},
+		&entity.UnknownEvent{PostType: entity.EventPostTypeMessageSent, SelfId: 1},
+	}
+	for _, event := range ignored {
+		require.True(t, IsSelfMessage(event))
+
+		quickOp, err := dispatcher.HandleEvent(context.Background(), event)
+		require.NoError(t, err)
+		require.Nil(t, quickOp)
+	}
+
+	require.Zero(t, called)
+
+	handled := []entity.Event{
+		&entity.PrivateMessageEvent{PostType: entity.EventPostTypeMessage, SelfId: 1, UserId: 2},
+		&entity.GroupMessageEvent{PostType: entity.EventPostTypeMessage, SelfId: 1, UserId: 2},
+		&entity.GroupCardEvent{PostType: entity.EventPostTypeNotice, SelfId: 1},
+	}
+	for _, event := range handled {
+		require.False(t, IsSelfMessage(event))
+
+		quickOp, err := dispatcher.HandleEvent(context.Background(), event)
+		require.NoError(t, err)
+		require.NotNil(t, quickOp)
+	}
+
+	require.Equal(t, len(handled), called)
+}
+
+type extNoticeEventService struct {
+	UnimplementedOneBotEventService
+
+	essence *entity.EssenceEvent
+}
+
+func (s *extNoticeEventService) HandleEssenceDelete(_ context.Context, ev *entity.EssenceEvent) error {
+	s.essence = ev
+
+	return nil
+}
+
+func TestRegisterGeneratedEvents_ExtensionNotice(t *testing.T) {
+	t.Parallel()
+
+	svc := &extNoticeEventService{}
+	eventDisp := NewEventDispatcher()
+	RegisterGeneratedEvents(eventDisp, svc)
+
+	event, err := entity.ParseEvent([]byte(
+		`{"post_type":"notice","notice_type":"essence","sub_type":"delete","group_id":1,"message_id":2}`))
+	require.NoError(t, err)
+
+	quickOp, err := eventDisp.HandleEvent(context.Background(), event)
+	require.NoError(t, err)
+	require.Nil(t, quickOp)
+	require.NotNil(t, svc.essence)
+	require.Equal(t, entity.EventEssenceSubTypeDelete, svc.essence.GetSubType())
+	require.Equal(t, int64(2), svc.essence.GetMessageId())
+}
+
+type quickOpEventService struct {
+	UnimplementedOneBotEventService
+}
+
+func (*quickOpEventService) HandlePrivateMessage(
+	_ context.Context, ev *entity.PrivateMessageEvent,
+) (*entity.MessageQuickOperation, error) {
+	if ev.RawMessage == "" {
+		return nil, nil //nolint:nilnil // 测试代码中返回 nil, nil 表示没有快速操作
+	}
+
+	return (&entity.MessageQuickOperation{}).SetReply(entity.NewMessage().Text(ev.RawMessage).Build()), nil
+}
+
+func (*quickOpEventService) HandleGroupRequestAdd(
+	_ context.Context, _ *entity.GroupRequestEvent,
+) (*entity.GroupRequestQuickOperation, error) {
+	return (&entity.GroupRequestQuickOperation{}).SetApprove(false).SetReason("no"), nil
+}
+
+func (*quickOpEventService) HandleHeartbeat(_ context.Context, _ *entity.HeartbeatEvent) error {
+	return nil
+}
+
+func TestHTTPServer_EventPath_TypedQuickOperation(t *testing.T) {
+	t.Parallel()
+
+	eventDisp := NewEventDispatcher()
+	RegisterGeneratedEvents(eventDisp, &quickOpEventService{})
+
+	server := NewHTTPServer(WithHTTPConfig(HTTPConfig{EventPath: "/event"}), WithEventHandler(eventDisp))
+
+	cases := []struct {
+		name       string
+		payload    string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "message_reply",
+			payload:    `{"post_type":"message","message_type":"private","sub_type":"friend","raw_message":"hi"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"reply":[{"type":"text","data":{"text":"hi"}}]}`,
+		},
+		{
+			name:       "message_nil_quick_operation",
+			payload:    `{"post_type":"message","message_type":"private","sub_type":"friend"}`,
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "group_request_reject",
+			payload:    `{"post_type":"request","request_type":"group","sub_type":"add","flag":"f"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `{"approve":false,"reason":"no"}`,
+		},
+		{
+			name:       "heartbeat_without_quick_operation",
+			payload:    `{"post_type":"meta_event","meta_event_type":"heartbeat","interval":1}`,
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/event", bytes.NewBufferString(tc.payload))
+			req.Header.Set("Content-Type", "application/json")
+			server.Handler().ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.wantStatus, recorder.Code)
+
+			if tc.wantBody != "" {
+				require.JSONEq(t, tc.wantBody, recorder.Body.String())
+			}
+		})
+	}
+}
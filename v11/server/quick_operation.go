@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+)
+
+// EmulateQuickOperation 包装事件处理器，模拟快速操作
+// WebSocket 等传输层无法通过上报响应返回快速操作，包装后的处理器在 handler 返回非空快速操作时，
+// 以原始事件为上下文，通过 caller 调用 .handle_quick_operation 动作交由 OneBot 实现执行.
+// 快速操作执行后不再向上返回，caller 通常为连接到 OneBot 实现的动作客户端.
+func EmulateQuickOperation(handler EventRequestHandler, caller dispatcher.ActionRequestHandler) EventRequestHandler {
+	return EventRequestHandlerFunc(func(ctx context.Context, event entity.Event) (entity.QuickOperation, error) {
+		quickOp, err := handler.HandleEvent(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+
+		if quickOp == nil || quickOp.IsEmpty() {
+			return nil, nil //nolint:nilnil // 没有快速操作
+		}
+
+		err = CallQuickOperation(ctx, caller, event, quickOp)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil //nolint:nilnil // 快速操作已通过动作执行
+	})
+}
+
+// CallQuickOperation 通过 .handle_quick_operation 动作对事件执行快速操作.
+func CallQuickOperation(
+	ctx context.Context,
+	caller dispatcher.ActionRequestHandler,
+	event entity.Event,
+	quickOp entity.QuickOperation,
+) error {
+	req, err := entity.NewHandleQuickOperationRequest(event, quickOp)
+	if err != nil {
+		return fmt.Errorf("build quick operation request: %w", err)
+	}
+
+	var params map[string]any
+
+	err = util.JsonTagMapping(req, &params)
+	if err != nil {
+		return fmt.Errorf("encode quick operation params: %w", err)
+	}
+
+	resp, err := caller.HandleActionRequest(ctx, &entity.ActionRequest{
+		Action: entity.HandleQuickOperationAction,
+		Params: params,
+	})
+	if err != nil {
+		return fmt.Errorf("call %s: %w", entity.HandleQuickOperationAction, err)
+	}
+
+	if resp != nil && resp.Status == entity.StatusFailed {
+		return &entity.ActionError{
+			UrlPath: entity.HandleQuickOperationAction,
+			Status:  resp.Status,
+			Retcode: resp.Retcode,
+			Message: resp.Message,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmulateQuickOperation(t *testing.T) {
+	t.Parallel()
+
+	var sent *entity.SendMsgRequest
+
+	caller := dispatcher.NewDispatcher()
+	caller.Register("send_msg", dispatcher.APIFuncToActionHandler(
+		func(_ context.Context, req *entity.SendMsgRequest) (*entity.ActionResponse[entity.SendMsgResponse], error) {
+			sent = req
+
+			return &entity.ActionResponse[entity.SendMsgResponse]{Status: entity.StatusOK}, nil
+		}))
+	caller.Register(entity.HandleQuickOperationAction, dispatcher.QuickOperationHandler(caller))
+
+	event := &entity.PrivateMessageEvent{
+		PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypePrivate, UserId: 10001,
+	}
+	reply := &entity.MessageValue{Type: entity.MessageValueTypeString, StringValue: "pong"}
+
+	handler := EmulateQuickOperation(EventRequestHandlerFunc(
+		func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+			return (&entity.MessageQuickOperation{}).SetReply(reply), nil
+		}), caller)
+
+	quickOp, err := handler.HandleEvent(context.Background(), event)
+	require.NoError(t, err)
+	require.Nil(t, quickOp)
+	require.Equal(t, &entity.SendMsgRequest{
+		MessageType: entity.MessageTypePrivate, UserId: 10001, Message: reply,
+	}, sent)
+
+	// 空快速操作不调用动作
+	sent = nil
+	handler = EmulateQuickOperation(EventRequestHandlerFunc(
+		func(_ context.Context, _ entity.Event) (entity.QuickOperation, error) {
+			return &entity.MessageQuickOperation{}, nil
+		}), caller)
+
+	quickOp, err = handler.HandleEvent(context.Background(), event)
+	require.NoError(t, err)
+	require.Nil(t, quickOp)
+	require.Nil(t, sent)
+}
+
+func TestCallQuickOperation_Failed(t *testing.T) {
+	t.Parallel()
+
+	caller := dispatcher.NewDispatcher()
+	caller.Register(entity.HandleQuickOperationAction,
+		func(_ context.Context, params map[string]any) (*entity.ActionRawResponse, error) {
+			eventContext, _ := params["context"].(map[string]any)
+			require.Equal(t, "message", eventContext["post_type"])
+			require.Equal(t, map[string]any{"delete": true}, params["operation"])
+
+			return &entity.ActionRawResponse{Status: entity.StatusFailed, Retcode: 102}, nil
+		})
+
+	err := CallQuickOperation(context.Background(), caller, &entity.GroupMessageEvent{
+		PostType: entity.EventPostTypeMessage, MessageType: entity.EventMessageTypeGroup,
+	}, (&entity.MessageQuickOperation{}).SetDelete(true))
+
+	var actionErr *entity.ActionError
+
+	require.ErrorAs(t, err, &actionErr)
+	require.Equal(t, entity.ActionResponseRetcode(102), actionErr.Retcode)
+	require.Equal(t, entity.HandleQuickOperationAction, actionErr.UrlPath)
+}
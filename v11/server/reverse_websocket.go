@@ -0,0 +1,508 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/q1bksuu/onebot-go-sdk/v11/internal/util"
+	wsinternal "github.com/q1bksuu/onebot-go-sdk/v11/internal/ws"
+)
+
+const (
+	defaultReverseWSDispatchWorkers   = 16
+	defaultReverseWSDispatchQueueSize = 256
+)
+
+var (
+	errReverseWSConnReplaced = errors.New("replaced by a new connection")
+	errReverseWSServerClosed = errors.New("reverse websocket server closed")
+)
+
+// ReverseWSClientRole 反向 WebSocket 连接角色，对应 X-Client-Role 请求头.
+type ReverseWSClientRole string
+
+const (
+	// ReverseWSRoleUniversal 通用连接，同时推送事件与接受动作请求.
+	ReverseWSRoleUniversal ReverseWSClientRole = "Universal"
+	// ReverseWSRoleAPI 动作连接，只接受动作请求.
+	ReverseWSRoleAPI ReverseWSClientRole = "API"
+	// ReverseWSRoleEvent 事件连接，只推送事件.
+	ReverseWSRoleEvent ReverseWSClientRole = "Event"
+)
+
+func (r ReverseWSClientRole) sessionTransport() SessionTransport {
+	switch {
+	case r == ReverseWSRoleAPI:
+		return SessionTransportReverseWSAPI
+	case r == ReverseWSRoleEvent:
+		return SessionTransportReverseWSEvent
+	default:
+		return SessionTransportReverseWSUniversal
+	}
+}
+
+// ReverseWSConfig 反向 WebSocket 服务配置.
+type ReverseWSConfig struct {
+	Addr          string                     // 监听地址，例 ":8080"
+	PathPrefix    string                     // 路径前缀，可为空或"/"，前缀下的任意路径均接受连接
+	AccessToken   string                     // 可选鉴权，若为空则不校验
+	CheckOrigin   func(r *http.Request) bool // 可选跨域校验，默认全放行
+	ReadTimeout   time.Duration              // 读取超时（可选），默认 0
+	WriteTimeout  time.Duration              // 写入超时（可选），默认 0
+	IdleTimeout   time.Duration              // 空闲超时（可选），默认 0
+	ActionTimeout time.Duration              // 单次动作调用超时（可选），默认 0 表示仅受调用方 ctx 限制
+}
+
+// ReverseWebSocketServer 机器人应用侧的反向 WebSocket 服务，接受 go-cqhttp / NapCat 等 OneBot 实现的连接
+// 连接通过 X-Self-ID 区分机器人，通过 X-Client-Role 区分 Universal / API / Event 角色，
+// 缺少 X-Client-Role 时根据路径后缀 /api、/event 推断，否则视为 Universal.
+// 收到的事件交由 EventRequestHandler 处理，处理器返回的快速操作通过该机器人的连接以 .handle_quick_operation 执行.
+type ReverseWebSocketServer struct {
+	*BaseServer
+
+	cfg          ReverseWSConfig
+	eventHandler EventRequestHandler
+	errorHandler func(error)
+	sessions     *SessionRegistry
+	upgrader     websocket.Upgrader
+	workers      int
+	queueSize    int
+
+	mu   sync.Mutex
+	bots map[int64]map[ReverseWSClientRole]*wsinternal.Conn
+}
+
+// ReverseWebSocketServerOption 用于配置 ReverseWebSocketServer 的选项函数类型.
+type ReverseWebSocketServerOption func(*ReverseWebSocketServer)
+
+// WithReverseWSConfig 设置反向 WebSocket 服务配置（会覆盖之前的配置）.
+func WithReverseWSConfig(cfg ReverseWSConfig) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg = cfg
+	}
+}
+
+// WithReverseWSAddr 设置监听地址.
+func WithReverseWSAddr(addr string) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg.Addr = addr
+	}
+}
+
+// WithReverseWSPathPrefix 设置路径前缀.
+func WithReverseWSPathPrefix(prefix string) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg.PathPrefix = prefix
+	}
+}
+
+// WithReverseWSAccessToken 设置访问令牌.
+func WithReverseWSAccessToken(token string) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg.AccessToken = token
+	}
+}
+
+// WithReverseWSCheckOrigin 设置跨域检查函数.
+func WithReverseWSCheckOrigin(checkOrigin func(r *http.Request) bool) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg.CheckOrigin = checkOrigin
+	}
+}
+
+// WithReverseWSActionTimeout 设置单次动作调用超时.
+func WithReverseWSActionTimeout(timeout time.Duration) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.cfg.ActionTimeout = timeout
+	}
+}
+
+// WithReverseWSEventHandler 设置事件处理器.
+func WithReverseWSEventHandler(handler EventRequestHandler) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.eventHandler = handler
+	}
+}
+
+// WithReverseWSErrorHandler 设置错误回调，用于接收事件解码、事件处理与快速操作执行中的错误.
+func WithReverseWSErrorHandler(fn func(error)) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.errorHandler = fn
+	}
+}
+
+// WithReverseWSSessionRegistry 设置会话注册表，机器人连接与断开时登记到注册表.
+func WithReverseWSSessionRegistry(registry *SessionRegistry) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.sessions = registry
+	}
+}
+
+// WithReverseWSEventDispatch 设置每个连接处理事件的工作 goroutine 数与等待处理的事件队列长度，默认 16 与 256
+// workers 为 1 时按接收顺序串行处理事件；队列已满时丢弃新事件，并以 ErrEventQueueFull 报告给错误回调.
+func WithReverseWSEventDispatch(workers, queueSize int) ReverseWebSocketServerOption {
+	return func(s *ReverseWebSocketServer) {
+		s.workers = workers
+		s.queueSize = queueSize
+	}
+}
+
+// NewReverseWebSocketServer 创建 ReverseWebSocketServer，配置由 opts 提供. 若传入 CheckOrigin 为 nil，则允许任意来源.
+func NewReverseWebSocketServer(opts ...ReverseWebSocketServerOption) *ReverseWebSocketServer {
+	server := &ReverseWebSocketServer{
+		cfg:       ReverseWSConfig{},
+		bots:      make(map[int64]map[ReverseWSClientRole]*wsinternal.Conn),
+		workers:   defaultReverseWSDispatchWorkers,
+		queueSize: defaultReverseWSDispatchQueueSize,
+	}
+
+	// 应用选项（顺序生效，后者覆盖前者）
+	for _, opt := range opts {
+		opt(server)
+	}
+
+	server.workers = max(server.workers, 1)
+	server.queueSize = max(server.queueSize, 0)
+
+	upgrader := websocket.Upgrader{CheckOrigin: server.cfg.CheckOrigin}
+	if upgrader.CheckOrigin == nil {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+
+	server.upgrader = upgrader
+
+	prefix := util.NormalizePath(server.cfg.PathPrefix)
+
+	mux := http.NewServeMux()
+	if prefix != "" {
+		mux.HandleFunc(prefix, server.handleConn)
+	}
+
+	mux.HandleFunc(prefix+"/", server.handleConn)
+
+	baseCfg := ServerConfig{
+		Addr:         server.cfg.Addr,
+		ReadTimeout:  server.cfg.ReadTimeout,
+		WriteTimeout: server.cfg.WriteTimeout,
+		IdleTimeout:  server.cfg.IdleTimeout,
+	}
+	server.BaseServer = NewBaseServer(baseCfg, mux)
+
+	return server
+}
+
+// Start 启动反向 WebSocket 服务器（异步监听）.
+func (s *ReverseWebSocketServer) Start(ctx context.Context) error {
+	return s.BaseServer.Start(ctx, func(context.Context) error {
+		s.closeAllConns()
+
+		return nil
+	})
+}
+
+// Shutdown 优雅关闭，关闭所有连接.
+func (s *ReverseWebSocketServer) Shutdown(ctx context.Context) error {
+	s.closeAllConns()
+
+	return s.BaseServer.Shutdown(ctx)
+}
+
+// Handler 返回 http.Handler，便于挂载到外部路由.
+func (s *ReverseWebSocketServer) Handler() http.Handler {
+	return s.Srv.Handler
+}
+
+// Bot 返回指定机器人的动作调用方，机器人当前没有任何连接时 ok 为 false
+// 返回的 ReverseWSBot 在机器人重连后仍然有效，可通过 client.NewActionClient 适配为 client.API.
+func (s *ReverseWebSocketServer) Bot(selfID int64) (*ReverseWSBot, bool) {
+	s.mu.Lock()
+	_, ok := s.bots[selfID]
+	s.mu.Unlock()
+
+	return &ReverseWSBot{selfID: selfID, server: s}, ok
+}
+
+func (s *ReverseWebSocketServer) handleConn(w http.ResponseWriter, r *http.Request) {
+	if errResp := checkWSAccess(r, s.cfg.AccessToken); errResp != nil {
+		writeWSHandshakeError(w, errResp)
+
+		return
+	}
+
+	selfID, role, err := parseReverseWSHeaders(r)
+	if err != nil {
+		writeWSHandshakeError(w, &entity.ActionResponseEnvelope{
+			ActionRawResponse: entity.ActionRawResponse{
+				Status:  entity.StatusFailed,
+				Retcode: entity.RetcodeBadRequest,
+				Message: err.Error(),
+			},
+		})
+
+		return
+	}
+
+	raw, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	conn := wsinternal.NewConn(raw, s.cfg.WriteTimeout)
+	if old := s.attach(selfID, role, conn); old != nil {
+		old.Close(errReverseWSConnReplaced)
+	} else if s.sessions != nil {
+		s.sessions.Connect(selfID, role.sessionTransport(), s.sessionCaller(selfID, role))
+	}
+
+	s.serveConn(r.Context(), selfID, role, conn)
+
+	if s.detach(selfID, role, conn) && s.sessions != nil {
+		s.sessions.Disconnect(selfID, role.sessionTransport())
+	}
+}
+
+// serveConn 读取连接上的消息直到连接断开，事件放入该连接的队列交由工作 goroutine 处理
+// 读取循环不等待事件处理，连接断开后等待队列中的事件处理结束.
+func (s *ReverseWebSocketServer) serveConn(
+	ctx context.Context, selfID int64, role ReverseWSClientRole, conn *wsinternal.Conn,
+) {
+	var wg sync.WaitGroup
+
+	queue := make(chan entity.Event, s.queueSize)
+
+	defer wg.Wait()
+	defer close(queue)
+
+	s.startWorkers(ctx, selfID, queue, &wg)
+
+	for {
+		_, data, err := conn.Raw().ReadMessage()
+		if err != nil {
+			conn.Close(err)
+
+			return
+		}
+
+		if role != ReverseWSRoleEvent && conn.Resolve(data) {
+			continue
+		}
+
+		if role == ReverseWSRoleAPI {
+			continue
+		}
+
+		event, err := entity.ParseEvent(data)
+		if err != nil {
+			s.reportError(fmt.Errorf("%w: decode event from bot %d: %w", entity.ErrProtocol, selfID, err))
+
+			continue
+		}
+
+		if s.sessions != nil {
+			s.sessions.Seen(selfID, role.sessionTransport())
+		}
+
+		select {
+		case queue <- event:
+		default:
+			s.reportError(fmt.Errorf("%w: dispatch %s event from bot %d", ErrEventQueueFull, event.GetPostType(), selfID))
+		}
+	}
+}
+
+// startWorkers 启动处理 queue 中事件的工作 goroutine.
+func (s *ReverseWebSocketServer) startWorkers(
+	ctx context.Context, selfID int64, queue <-chan entity.Event, wg *sync.WaitGroup,
+) {
+	for range s.workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for event := range queue {
+				s.handleEvent(ctx, selfID, event)
+			}
+		}()
+	}
+}
+
+func (s *ReverseWebSocketServer) handleEvent(ctx context.Context, selfID int64, event entity.Event) {
+	if s.eventHandler == nil {
+		return
+	}
+
+	quickOp, err := s.eventHandler.HandleEvent(ctx, event)
+	if err != nil {
+		s.reportError(fmt.Errorf("handle event from bot %d: %w", selfID, err))
+
+		return
+	}
+
+	if quickOp == nil || quickOp.IsEmpty() {
+		return
+	}
+
+	err = CallQuickOperation(ctx, &ReverseWSBot{selfID: selfID, server: s}, event, quickOp)
+	if err != nil {
+		s.reportError(err)
+	}
+}
+
+// attach 登记连接，返回被替换的同角色旧连接.
+func (s *ReverseWebSocketServer) attach(
+	selfID int64, role ReverseWSClientRole, conn *wsinternal.Conn,
+) *wsinternal.Conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conns, ok := s.bots[selfID]
+	if !ok {
+		conns = make(map[ReverseWSClientRole]*wsinternal.Conn)
+		s.bots[selfID] = conns
+	}
+
+	old := conns[role]
+	conns[role] = conn
+
+	return old
+}
+
+// detach 注销连接，连接已被替换时返回 false.
+func (s *ReverseWebSocketServer) detach(selfID int64, role ReverseWSClientRole, conn *wsinternal.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conns := s.bots[selfID]
+	if conns[role] != conn {
+		return false
+	}
+
+	delete(conns, role)
+
+	if len(conns) == 0 {
+		delete(s.bots, selfID)
+	}
+
+	return true
+}
+
+// actionConn 返回机器人用于调用动作的连接，优先使用 API 连接.
+func (s *ReverseWebSocketServer) actionConn(selfID int64) *wsinternal.Conn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conns := s.bots[selfID]
+	if conn := conns[ReverseWSRoleAPI]; conn != nil {
+		return conn
+	}
+
+	return conns[ReverseWSRoleUniversal]
+}
+
+// sessionCaller 返回登记到会话注册表的动作调用方，事件连接不能调用动作.
+func (s *ReverseWebSocketServer) sessionCaller(selfID int64, role ReverseWSClientRole) dispatcher.ActionRequestHandler {
+	if role == ReverseWSRoleEvent {
+		return nil
+	}
+
+	return &ReverseWSBot{selfID: selfID, server: s}
+}
+
+func (s *ReverseWebSocketServer) closeAllConns() {
+	s.mu.Lock()
+
+	var conns []*wsinternal.Conn
+
+	for _, roles := range s.bots {
+		for _, conn := range roles {
+			conns = append(conns, conn)
+		}
+	}
+
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.Close(errReverseWSServerClosed)
+	}
+}
+
+func (s *ReverseWebSocketServer) reportError(err error) {
+	if s.errorHandler != nil {
+		s.errorHandler(err)
+	}
+}
+
+// ReverseWSBot 通过反向 WebSocket 连接的机器人，实现 dispatcher.ActionRequestHandler
+// 动作请求携带 echo 通过该机器人的 API 连接发送，没有 API 连接时使用 Universal 连接.
+type ReverseWSBot struct {
+	selfID int64
+	server *ReverseWebSocketServer
+}
+
+// SelfID 返回机器人 QQ 号.
+func (b *ReverseWSBot) SelfID() int64 {
+	return b.selfID
+}
+
+// HandleActionRequest 发送动作请求并等待响应，失败的动作响应原样返回，不转换为错误.
+func (b *ReverseWSBot) HandleActionRequest(
+	ctx context.Context, req *entity.ActionRequest,
+) (*entity.ActionRawResponse, error) {
+	conn := b.server.actionConn(b.selfID)
+	if conn == nil {
+		return nil, fmt.Errorf("%w: %w: %d", entity.ErrNetwork, ErrBotNotConnected, b.selfID)
+	}
+
+	if timeout := b.server.cfg.ActionTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	resp, err := conn.Call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("call bot %d: %w", b.selfID, err)
+	}
+
+	return resp, nil
+}
+
+// parseReverseWSHeaders 解析握手请求中的 X-Self-ID 与 X-Client-Role.
+func parseReverseWSHeaders(r *http.Request) (int64, ReverseWSClientRole, error) {
+	selfID, ok := parseSelfIDHeader(r)
+	if !ok {
+		return 0, "", fmt.Errorf("%w", ErrInvalidSelfID)
+	}
+
+	header := strings.TrimSpace(r.Header.Get("X-Client-Role"))
+	if header == "" {
+		path := strings.TrimRight(r.URL.Path, "/")
+
+		switch {
+		case strings.HasSuffix(path, "/api"):
+			return selfID, ReverseWSRoleAPI, nil
+		case strings.HasSuffix(path, "/event"):
+			return selfID, ReverseWSRoleEvent, nil
+		default:
+			return selfID, ReverseWSRoleUniversal, nil
+		}
+	}
+
+	for _, role := range []ReverseWSClientRole{ReverseWSRoleUniversal, ReverseWSRoleAPI, ReverseWSRoleEvent} {
+		if strings.EqualFold(header, string(role)) {
+			return selfID, role, nil
+		}
+	}
+
+	return 0, "", fmt.Errorf("%w: %s", ErrInvalidClientRole, header)
+}
@@ -0,0 +1,373 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+const reverseWSTestGroupMessage = `{"time":1,"self_id":10000,"post_type":"message","message_type":"group",` +
+	`"sub_type":"normal","message_id":2,"group_id":30000,"user_id":20000,"message":"ping",` +
+	`"raw_message":"ping","font":0}`
+
+func newTestReverseWebSocketServer(
+	t *testing.T, opts ...ReverseWebSocketServerOption,
+) (*ReverseWebSocketServer, *httptest.Server) {
+	t.Helper()
+
+	s := NewReverseWebSocketServer(opts...)
+	ts := httptest.NewServer(s.Handler())
+	t.Cleanup(ts.Close)
+
+	return s, ts
+}
+
+func reverseWSHeader(selfID int64, role ReverseWSClientRole) http.Header {
+	header := make(http.Header)
+	header.Set("X-Self-ID", strconv.FormatInt(selfID, 10))
+
+	if role != "" {
+		header.Set("X-Client-Role", string(role))
+	}
+
+	return header
+}
+
+// replyQuickOperation 读取 .handle_quick_operation 请求并返回成功响应.
+func replyQuickOperation(t *testing.T, conn *websocket.Conn) *entity.HandleQuickOperationRequest {
+	t.Helper()
+
+	env := readJSON[entity.ActionRequestEnvelope](t, conn)
+	require.Equal(t, entity.HandleQuickOperationAction, env.Action)
+	require.NotEmpty(t, env.Echo)
+
+	require.NoError(t, conn.WriteJSON(&entity.ActionResponseEnvelope{
+		ActionRawResponse: entity.ActionRawResponse{Status: entity.StatusOK},
+		Echo:              env.Echo,
+	}))
+
+	data, err := json.Marshal(env.Params)
+	require.NoError(t, err)
+
+	var req entity.HandleQuickOperationRequest
+
+	require.NoError(t, json.Unmarshal(data, &req))
+
+	return &req
+}
+
+func replyQuickOp(events chan<- entity.Event) EventRequestHandler {
+	return EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+		events <- event
+
+		return &entity.MessageQuickOperation{Reply: entity.NewMessage().Text("pong").Build()}, nil
+	})
+}
+
+func TestReverseWebSocketServer_Universal(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan entity.Event, 1)
+	s, ts := newTestReverseWebSocketServer(t,
+		WithReverseWSAccessToken("token"),
+		WithReverseWSEventHandler(replyQuickOp(events)),
+	)
+
+	header := reverseWSHeader(10000, ReverseWSRoleUniversal)
+	header.Set("Authorization", "Bearer token")
+	conn := mustDialWS(t, wsURL(ts, "/onebot/v11/ws"), header)
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(reverseWSTestGroupMessage)))
+
+	event, ok := (<-events).(*entity.GroupMessageEvent)
+	require.True(t, ok)
+	require.Equal(t, int64(30000), event.GroupId)
+
+	req := replyQuickOperation(t, conn)
+	require.InDelta(t, 30000, req.Context["group_id"], 0)
+	require.NotNil(t, req.Operation["reply"])
+
+	bot, ok := s.Bot(10000)
+	require.True(t, ok)
+	require.Equal(t, int64(10000), bot.SelfID())
+
+	respCh := make(chan *entity.ActionRawResponse, 1)
+
+	go func() {
+		resp, err := bot.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "get_status"})
+		if err != nil {
+			respCh <- nil
+
+			return
+		}
+
+		respCh <- resp
+	}()
+
+	env := readJSON[entity.ActionRequestEnvelope](t, conn)
+	require.Equal(t, "get_status", env.Action)
+	require.NoError(t, conn.WriteJSON(&entity.ActionResponseEnvelope{
+		ActionRawResponse: entity.ActionRawResponse{Status: entity.StatusOK, Data: json.RawMessage(`{"online":true}`)},
+		Echo:              env.Echo,
+	}))
+
+	resp := <-respCh
+	require.NotNil(t, resp)
+	require.JSONEq(t, `{"online":true}`, string(resp.Data))
+}
+
+func TestReverseWebSocketServer_SeparateAPIAndEvent(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan entity.Event, 1)
+	s, ts := newTestReverseWebSocketServer(t,
+		WithReverseWSPathPrefix("/onebot"),
+		WithReverseWSEventHandler(replyQuickOp(events)),
+	)
+
+	// 未携带 X-Client-Role 时根据路径推断角色
+	apiConn := mustDialWS(t, wsURL(ts, "/onebot/api"), reverseWSHeader(10000, ""))
+	eventConn := mustDialWS(t, wsURL(ts, "/onebot/event"), reverseWSHeader(10000, ""))
+
+	defer func() {
+		_ = apiConn.Close()
+		_ = eventConn.Close()
+	}()
+
+	require.Eventually(t, func() bool {
+		return s.actionConn(10000) != nil
+	}, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, eventConn.WriteMessage(websocket.TextMessage, []byte(reverseWSTestGroupMessage)))
+	require.IsType(t, &entity.GroupMessageEvent{}, <-events)
+
+	// 快速操作通过 API 连接发送
+	req := replyQuickOperation(t, apiConn)
+	require.InDelta(t, 20000, req.Context["user_id"], 0)
+}
+
+// reverseWSGroupMessage 返回指定 message_id 的群消息事件.
+func reverseWSGroupMessage(messageID int) []byte {
+	return []byte(strings.Replace(reverseWSTestGroupMessage, `"message_id":2`, `"message_id":`+strconv.Itoa(messageID), 1))
+}
+
+func TestReverseWebSocketServer_DispatchLimit(t *testing.T) {
+	t.Parallel()
+
+	const (
+		workers = 2
+		sent    = 6
+	)
+
+	var running, peak atomic.Int32
+
+	started := make(chan struct{}, sent)
+	release := make(chan struct{})
+	handled := make(chan struct{}, sent)
+	handler := EventRequestHandlerFunc(func(context.Context, entity.Event) (entity.QuickOperation, error) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		started <- struct{}{}
+		<-release
+		running.Add(-1)
+		handled <- struct{}{}
+
+		return nil, nil //nolint:nilnil // 测试处理器不返回快速操作
+	})
+	_, ts := newTestReverseWebSocketServer(t,
+		WithReverseWSEventHandler(handler),
+		WithReverseWSEventDispatch(workers, sent),
+	)
+
+	conn := mustDialWS(t, wsURL(ts, "/event"), reverseWSHeader(10000, ""))
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	for i := range sent {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, reverseWSGroupMessage(i)))
+	}
+
+	// 工作 goroutine 全部阻塞后，其余事件留在队列中
+	for range workers {
+		<-started
+	}
+
+	require.Never(t, func() bool { return len(started) > 0 }, 50*time.Millisecond, 5*time.Millisecond)
+	close(release)
+
+	for range sent {
+		<-handled
+	}
+
+	require.LessOrEqual(t, peak.Load(), int32(workers))
+}
+
+func TestReverseWebSocketServer_DispatchQueueFull(t *testing.T) {
+	t.Parallel()
+
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	handled := make(chan int64, 4)
+	errs := make(chan error, 4)
+	handler := EventRequestHandlerFunc(func(_ context.Context, event entity.Event) (entity.QuickOperation, error) {
+		msg, _ := event.(*entity.GroupMessageEvent)
+		if msg.MessageId == 0 {
+			close(blocked)
+			<-release
+		}
+
+		handled <- msg.MessageId
+
+		return nil, nil //nolint:nilnil // 测试处理器不返回快速操作
+	})
+	_, ts := newTestReverseWebSocketServer(t,
+		WithReverseWSEventHandler(handler),
+		WithReverseWSEventDispatch(1, 1),
+		WithReverseWSErrorHandler(func(err error) { errs <- err }),
+	)
+
+	conn := mustDialWS(t, wsURL(ts, "/event"), reverseWSHeader(10000, ""))
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// 第一个事件阻塞处理器，队列只能容纳 1 个事件，其余事件被丢弃
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, reverseWSGroupMessage(0)))
+	<-blocked
+
+	for i := 1; i < 4; i++ {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, reverseWSGroupMessage(i)))
+	}
+
+	require.ErrorIs(t, <-errs, ErrEventQueueFull)
+	require.ErrorIs(t, <-errs, ErrEventQueueFull)
+	close(release)
+
+	require.Equal(t, []int64{0, 1}, []int64{<-handled, <-handled})
+}
+
+func TestReverseWebSocketServer_HandshakeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, ts := newTestReverseWebSocketServer(t, WithReverseWSAccessToken("token"))
+
+	withToken := func(header http.Header) http.Header {
+		header.Set("Authorization", "Bearer token")
+
+		return header
+	}
+
+	cases := []struct {
+		name   string
+		header http.Header
+		status int
+	}{
+		{name: "missing token", header: reverseWSHeader(10000, ReverseWSRoleUniversal), status: http.StatusUnauthorized},
+		{name: "missing self id", header: withToken(make(http.Header)), status: http.StatusBadRequest},
+		{name: "invalid role", header: withToken(reverseWSHeader(10000, "Admin")), status: http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, resp, err := websocket.DefaultDialer.Dial(wsURL(ts, "/"), tc.header)
+			if conn != nil {
+				_ = conn.Close()
+			}
+
+			require.ErrorIs(t, err, websocket.ErrBadHandshake)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.status, resp.StatusCode)
+			_ = resp.Body.Close()
+		})
+	}
+}
+
+func TestReverseWebSocketServer_BotDisconnected(t *testing.T) {
+	t.Parallel()
+
+	s, ts := newTestReverseWebSocketServer(t, WithReverseWSActionTimeout(time.Second))
+
+	bot, ok := s.Bot(10000)
+	require.False(t, ok)
+
+	_, err := bot.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "get_status"})
+	require.ErrorIs(t, err, ErrBotNotConnected)
+	require.ErrorIs(t, err, entity.ErrNetwork)
+
+	// 只有事件连接时无法调用动作
+	conn := mustDialWS(t, wsURL(ts, "/"), reverseWSHeader(10000, ReverseWSRoleEvent))
+
+	require.Eventually(t, func() bool {
+		_, ok := s.Bot(10000)
+
+		return ok
+	}, time.Second, 5*time.Millisecond)
+
+	_, err = bot.HandleActionRequest(context.Background(), &entity.ActionRequest{Action: "get_status"})
+	require.ErrorIs(t, err, ErrBotNotConnected)
+
+	_ = conn.Close()
+
+	require.Eventually(t, func() bool {
+		_, ok := s.Bot(10000)
+
+		return !ok
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestParseReverseWSHeaders(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		path   string
+		header http.Header
+		role   ReverseWSClientRole
+	}{
+		{path: "/", header: reverseWSHeader(1, "universal"), role: ReverseWSRoleUniversal},
+		{path: "/", header: reverseWSHeader(1, "API"), role: ReverseWSRoleAPI},
+		{path: "/ws/event/", header: reverseWSHeader(1, ""), role: ReverseWSRoleEvent},
+		{path: "/ws/api", header: reverseWSHeader(1, ""), role: ReverseWSRoleAPI},
+		{path: "/ws/api", header: reverseWSHeader(1, "Event"), role: ReverseWSRoleEvent},
+	}
+
+	for _, tc := range cases {
+		r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+		r.Header = tc.header
+
+		selfID, role, err := parseReverseWSHeaders(r)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), selfID)
+		require.Equal(t, tc.role, role)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header = reverseWSHeader(0, "")
+
+	_, _, err := parseReverseWSHeaders(r)
+	require.ErrorIs(t, err, ErrInvalidSelfID)
+}
@@ -0,0 +1,287 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+)
+
+// SessionTransport 机器人会话的传输方式.
+type SessionTransport string
+
+const (
+	// SessionTransportHTTP HTTP POST 事件上报.
+	SessionTransportHTTP SessionTransport = "http"
+	// SessionTransportWebSocket 正向 WebSocket 连接.
+	SessionTransportWebSocket SessionTransport = "ws"
+	// SessionTransportReverseWSUniversal 反向 WebSocket Universal 连接.
+	SessionTransportReverseWSUniversal SessionTransport = "ws_reverse_universal"
+	// SessionTransportReverseWSAPI 反向 WebSocket API 连接.
+	SessionTransportReverseWSAPI SessionTransport = "ws_reverse_api"
+	// SessionTransportReverseWSEvent 反向 WebSocket Event 连接.
+	SessionTransportReverseWSEvent SessionTransport = "ws_reverse_event"
+)
+
+// BotSession 机器人会话快照.
+type BotSession struct {
+	// SelfID 机器人 QQ 号
+	SelfID int64
+	// Transports 当前在线的传输方式，按名称排序
+	Transports []SessionTransport
+	// ConnectedAt 本次上线时间
+	ConnectedAt time.Time
+	// LastSeenAt 最近一次连接或收到事件的时间
+	LastSeenAt time.Time
+	// DisconnectedAt 下线时间，仅在下线回调中有值
+	DisconnectedAt time.Time
+	// Caller 调用该机器人动作的处理器，没有可用的动作通道时为 nil
+	// 可通过 client.NewActionClient 适配为 client.API
+	Caller dispatcher.ActionRequestHandler
+}
+
+// SessionRegistry 按 self_id 跟踪已连接的机器人及其传输方式，可被多个服务共享
+// 机器人的第一个传输上线时触发上线回调，最后一个传输断开时触发下线回调.
+// HTTP 事件上报没有连接状态，通过 HTTP 上线的机器人需要调用 Disconnect 才会下线.
+type SessionRegistry struct {
+	mu       sync.Mutex
+	sessions map[int64]*botSessionState
+
+	onOnline  []func(BotSession)
+	onOffline []func(BotSession)
+	now       func() time.Time
+}
+
+type botSessionState struct {
+	transports  map[SessionTransport]int // 同一传输可能有多个连接，按连接数计数
+	connectedAt time.Time
+	lastSeenAt  time.Time
+	caller      dispatcher.ActionRequestHandler
+}
+
+// SessionRegistryOption 用于配置 SessionRegistry 的选项函数类型.
+type SessionRegistryOption func(*SessionRegistry)
+
+// WithSessionOnline 添加机器人上线回调，回调在锁外同步执行.
+func WithSessionOnline(fn func(BotSession)) SessionRegistryOption {
+	return func(r *SessionRegistry) {
+		r.onOnline = append(r.onOnline, fn)
+	}
+}
+
+// WithSessionOffline 添加机器人下线回调，回调在锁外同步执行.
+func WithSessionOffline(fn func(BotSession)) SessionRegistryOption {
+	return func(r *SessionRegistry) {
+		r.onOffline = append(r.onOffline, fn)
+	}
+}
+
+// NewSessionRegistry 创建会话注册表.
+func NewSessionRegistry(opts ...SessionRegistryOption) *SessionRegistry {
+	registry := &SessionRegistry{
+		sessions: make(map[int64]*botSessionState),
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(registry)
+	}
+
+	return registry
+}
+
+// Connect 登记机器人的一个传输连接，caller 非 nil 时更新机器人的动作调用方.
+func (r *SessionRegistry) Connect(selfID int64, transport SessionTransport, caller dispatcher.ActionRequestHandler) {
+	r.mu.Lock()
+	session, online := r.connectLocked(selfID, transport, caller)
+	r.mu.Unlock()
+
+	if online {
+		r.notifyOnline(session)
+	}
+}
+
+// Disconnect 注销机器人的一个传输连接，机器人没有任何传输时下线.
+func (r *SessionRegistry) Disconnect(selfID int64, transport SessionTransport) {
+	r.mu.Lock()
+
+	state, ok := r.sessions[selfID]
+	if !ok || state.transports[transport] == 0 {
+		r.mu.Unlock()
+
+		return
+	}
+
+	state.transports[transport]--
+	if state.transports[transport] == 0 {
+		delete(state.transports, transport)
+	}
+
+	if len(state.transports) > 0 {
+		r.mu.Unlock()
+
+		return
+	}
+
+	delete(r.sessions, selfID)
+
+	session := state.snapshot(selfID)
+	session.DisconnectedAt = r.now()
+	r.mu.Unlock()
+
+	for _, fn := range r.onOffline {
+		fn(session)
+	}
+}
+
+// Seen 记录机器人在 transport 上的活动，机器人尚未通过该传输上线时登记上线
+// 用于没有连接状态的 HTTP 事件上报；检查与登记在同一次加锁中完成，并发的首个事件只登记一次.
+func (r *SessionRegistry) Seen(selfID int64, transport SessionTransport) {
+	r.mu.Lock()
+
+	state, ok := r.sessions[selfID]
+	if ok && state.transports[transport] > 0 {
+		state.lastSeenAt = r.now()
+		r.mu.Unlock()
+
+		return
+	}
+
+	session, online := r.connectLocked(selfID, transport, nil)
+	r.mu.Unlock()
+
+	if online {
+		r.notifyOnline(session)
+	}
+}
+
+// Get 返回机器人的会话快照.
+func (r *SessionRegistry) Get(selfID int64) (BotSession, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	state, ok := r.sessions[selfID]
+	if !ok {
+		return BotSession{}, false
+	}
+
+	return state.snapshot(selfID), true
+}
+
+// Caller 返回机器人的动作调用方，机器人不在线或没有动作通道时 ok 为 false.
+func (r *SessionRegistry) Caller(selfID int64) (dispatcher.ActionRequestHandler, bool) {
+	session, ok := r.Get(selfID)
+	if !ok || session.Caller == nil {
+		return nil, false
+	}
+
+	return session.Caller, true
+}
+
+// Sessions 返回所有在线机器人的会话快照，按 SelfID 排序.
+func (r *SessionRegistry) Sessions() []BotSession {
+	r.mu.Lock()
+
+	sessions := make([]BotSession, 0, len(r.sessions))
+	for selfID, state := range r.sessions {
+		sessions = append(sessions, state.snapshot(selfID))
+	}
+
+	r.mu.Unlock()
+
+	slices.SortFunc(sessions, func(a, b BotSession) int {
+		return compareInt64(a.SelfID, b.SelfID)
+	})
+
+	return sessions
+}
+
+// Range 按 SelfID 顺序遍历在线机器人，fn 返回 false 时停止.
+func (r *SessionRegistry) Range(fn func(BotSession) bool) {
+	for _, session := range r.Sessions() {
+		if !fn(session) {
+			return
+		}
+	}
+}
+
+// Len 返回在线机器人数量.
+func (r *SessionRegistry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.sessions)
+}
+
+// connectLocked 在持有锁时登记传输连接，返回会话快照，机器人因此上线时 online 为 true.
+func (r *SessionRegistry) connectLocked(
+	selfID int64, transport SessionTransport, caller dispatcher.ActionRequestHandler,
+) (BotSession, bool) {
+	now := r.now()
+
+	state, ok := r.sessions[selfID]
+	if !ok {
+		state = &botSessionState{
+			transports:  make(map[SessionTransport]int),
+			connectedAt: now,
+		}
+		r.sessions[selfID] = state
+	}
+
+	state.transports[transport]++
+	state.lastSeenAt = now
+
+	if caller != nil {
+		state.caller = caller
+	}
+
+	return state.snapshot(selfID), !ok
+}
+
+func (r *SessionRegistry) notifyOnline(session BotSession) {
+	for _, fn := range r.onOnline {
+		fn(session)
+	}
+}
+
+func (s *botSessionState) snapshot(selfID int64) BotSession {
+	transports := make([]SessionTransport, 0, len(s.transports))
+	for transport := range s.transports {
+		transports = append(transports, transport)
+	}
+
+	slices.Sort(transports)
+
+	return BotSession{
+		SelfID:      selfID,
+		Transports:  transports,
+		ConnectedAt: s.connectedAt,
+		LastSeenAt:  s.lastSeenAt,
+		Caller:      s.caller,
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// parseSelfIDHeader 解析请求中的 X-Self-ID 请求头.
+func parseSelfIDHeader(r *http.Request) (int64, bool) {
+	selfID, err := strconv.ParseInt(strings.TrimSpace(r.Header.Get("X-Self-ID")), 10, 64)
+	if err != nil || selfID <= 0 {
+		return 0, false
+	}
+
+	return selfID, true
+}
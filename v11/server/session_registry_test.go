@@ -0,0 +1,235 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/q1bksuu/onebot-go-sdk/v11/dispatcher"
+	"github.com/q1bksuu/onebot-go-sdk/v11/entity"
+	"github.com/stretchr/testify/require"
+)
+
+// sessionEvents 将上下线回调写入通道，便于测试断言.
+func sessionEvents() (*SessionRegistry, <-chan BotSession, <-chan BotSession) {
+	online := make(chan BotSession, 8)
+	offline := make(chan BotSession, 8)
+	registry := NewSessionRegistry(
+		WithSessionOnline(func(session BotSession) { online <- session }),
+		WithSessionOffline(func(session BotSession) { offline <- session }),
+	)
+
+	return registry, online, offline
+}
+
+func TestSessionRegistry_ConnectDisconnect(t *testing.T) {
+	t.Parallel()
+
+	registry, online, offline := sessionEvents()
+	caller := dispatcher.ActionRequestHandlerFunc(
+		func(context.Context, *entity.ActionRequest) (*entity.ActionRawResponse, error) {
+			return &entity.ActionRawResponse{Status: entity.StatusOK}, nil
+		},
+	)
+
+	registry.Connect(10000, SessionTransportReverseWSEvent, nil)
+	registry.Connect(10000, SessionTransportReverseWSAPI, caller)
+	registry.Connect(20000, SessionTransportHTTP, nil)
+
+	session := <-online
+	require.Equal(t, int64(10000), session.SelfID)
+	require.Equal(t, []SessionTransport{SessionTransportReverseWSEvent}, session.Transports)
+	require.Equal(t, int64(20000), (<-online).SelfID)
+	require.Empty(t, online)
+
+	session, ok := registry.Get(10000)
+	require.True(t, ok)
+	require.Equal(t,
+		[]SessionTransport{SessionTransportReverseWSAPI, SessionTransportReverseWSEvent}, session.Transports)
+	require.False(t, session.ConnectedAt.IsZero())
+
+	got, ok := registry.Caller(10000)
+	require.True(t, ok)
+	require.NotNil(t, got)
+
+	_, ok = registry.Caller(20000)
+	require.False(t, ok)
+
+	require.Equal(t, 2, registry.Len())
+
+	var ids []int64
+
+	registry.Range(func(session BotSession) bool {
+		ids = append(ids, session.SelfID)
+
+		return true
+	})
+	require.Equal(t, []int64{10000, 20000}, ids)
+
+	// 断开未登记的传输不影响会话
+	registry.Disconnect(10000, SessionTransportWebSocket)
+	registry.Disconnect(10000, SessionTransportReverseWSAPI)
+	require.Empty(t, offline)
+
+	registry.Disconnect(10000, SessionTransportReverseWSEvent)
+
+	session = <-offline
+	require.Equal(t, int64(10000), session.SelfID)
+	require.False(t, session.DisconnectedAt.IsZero())
+
+	_, ok = registry.Get(10000)
+	require.False(t, ok)
+	require.Equal(t, 1, registry.Len())
+}
+
+func TestSessionRegistry_SameTransportCounted(t *testing.T) {
+	t.Parallel()
+
+	registry, online, offline := sessionEvents()
+
+	registry.Connect(10000, SessionTransportWebSocket, nil)
+	registry.Connect(10000, SessionTransportWebSocket, nil)
+	require.Len(t, online, 1)
+
+	registry.Disconnect(10000, SessionTransportWebSocket)
+	require.Empty(t, offline)
+
+	registry.Disconnect(10000, SessionTransportWebSocket)
+	require.Len(t, offline, 1)
+}
+
+func TestSessionRegistry_Seen(t *testing.T) {
+	t.Parallel()
+
+	registry, online, _ := sessionEvents()
+
+	registry.Seen(10000, SessionTransportHTTP)
+	first, ok := registry.Get(10000)
+	require.True(t, ok)
+
+	time.Sleep(time.Millisecond)
+	registry.Seen(10000, SessionTransportHTTP)
+
+	second, ok := registry.Get(10000)
+	require.True(t, ok)
+	require.Len(t, online, 1)
+	require.Equal(t, first.ConnectedAt, second.ConnectedAt)
+	require.True(t, second.LastSeenAt.After(first.LastSeenAt))
+}
+
+func TestSessionRegistry_SeenConcurrent(t *testing.T) {
+	t.Parallel()
+
+	registry, online, offline := sessionEvents()
+
+	var wg sync.WaitGroup
+
+	for range 16 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			registry.Seen(10000, SessionTransportHTTP)
+		}()
+	}
+
+	wg.Wait()
+	require.Len(t, online, 1)
+
+	// 并发的首个事件只登记一次 HTTP 传输，一次 Disconnect 即下线
+	registry.Disconnect(10000, SessionTransportHTTP)
+	require.Len(t, offline, 1)
+	require.Zero(t, registry.Len())
+}
+
+func TestHTTPServer_EventPath_SessionRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, online, _ := sessionEvents()
+	server := NewHTTPServer(WithEventPath("/event"), WithHTTPSessionRegistry(registry))
+
+	post := func(selfIDHeader string) {
+		req := httptest.NewRequest(http.MethodPost, "/event", bytes.NewBufferString(reverseWSTestGroupMessage))
+		req.Header.Set("Content-Type", "application/json")
+
+		if selfIDHeader != "" {
+			req.Header.Set("X-Self-ID", selfIDHeader)
+		}
+
+		recorder := httptest.NewRecorder()
+		server.Handler().ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusNoContent, recorder.Code)
+	}
+
+	// 缺少 X-Self-ID 时使用事件中的 self_id
+	post("")
+	require.Equal(t, int64(10000), (<-online).SelfID)
+
+	post("10001")
+
+	session := <-online
+	require.Equal(t, int64(10001), session.SelfID)
+	require.Equal(t, []SessionTransport{SessionTransportHTTP}, session.Transports)
+	require.Equal(t, 2, registry.Len())
+}
+
+func TestWebSocketServer_SessionRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, online, offline := sessionEvents()
+	wsServer := NewWebSocketServer(WithWSSessionRegistry(registry))
+	ts := httptest.NewServer(wsServer.Handler())
+	t.Cleanup(ts.Close)
+
+	// 未携带 X-Self-ID 的连接不登记
+	anonymous := mustDialWS(t, wsURL(ts, "/event"), nil)
+	conn := mustDialWS(t, wsURL(ts, "/event"), reverseWSHeader(10000, ""))
+
+	session := <-online
+	require.Equal(t, int64(10000), session.SelfID)
+	require.Equal(t, []SessionTransport{SessionTransportWebSocket}, session.Transports)
+	require.Equal(t, 1, registry.Len())
+
+	_ = anonymous.Close()
+	_ = conn.Close()
+
+	require.Equal(t, int64(10000), (<-offline).SelfID)
+}
+
+func TestReverseWebSocketServer_SessionRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, online, offline := sessionEvents()
+	_, ts := newTestReverseWebSocketServer(t, WithReverseWSSessionRegistry(registry))
+
+	eventConn := mustDialWS(t, wsURL(ts, "/event"), reverseWSHeader(10000, ""))
+	require.Equal(t, []SessionTransport{SessionTransportReverseWSEvent}, (<-online).Transports)
+
+	_, ok := registry.Caller(10000)
+	require.False(t, ok)
+
+	apiConn := mustDialWS(t, wsURL(ts, "/api"), reverseWSHeader(10000, ""))
+
+	require.Eventually(t, func() bool {
+		_, ok := registry.Caller(10000)
+
+		return ok
+	}, time.Second, 5*time.Millisecond)
+
+	caller, _ := registry.Caller(10000)
+	bot, ok := caller.(*ReverseWSBot)
+	require.True(t, ok)
+	require.Equal(t, int64(10000), bot.SelfID())
+
+	_ = apiConn.Close()
+	_ = eventConn.Close()
+
+	session := <-offline
+	require.Equal(t, int64(10000), session.SelfID)
+	require.Empty(t, online)
+}
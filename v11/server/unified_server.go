@@ -30,8 +30,10 @@ type UnifiedHTTPConfig struct {
 	APIPathPrefix string
 	EventPath     string
 	AccessToken   string
+	Secret        string // 可选事件上报签名密钥
 	ActionHandler dispatcher.ActionRequestHandler
 	EventHandler  EventRequestHandler
+	Sessions      *SessionRegistry // 可选的会话注册表，可与 WS 共用
 }
 
 // UnifiedWSConfig 统一服务器中的 WebSocket 配置（仅包含独有字段）.
@@ -40,6 +42,7 @@ type UnifiedWSConfig struct {
 	AccessToken   string
 	CheckOrigin   func(r *http.Request) bool
 	ActionHandler dispatcher.ActionRequestHandler
+	Sessions      *SessionRegistry // 可选的会话注册表，可与 HTTP 共用
 }
 
 // NewUnifiedServer 创建统一服务器.
@@ -54,11 +57,13 @@ func NewUnifiedServer(cfg UnifiedConfig) *UnifiedServer {
 		WriteTimeout:      cfg.WriteTimeout,
 		IdleTimeout:       cfg.IdleTimeout,
 		AccessToken:       cfg.HTTP.AccessToken,
+		Secret:            cfg.HTTP.Secret,
 	}
 	httpSrv := NewHTTPServer(
 		WithHTTPConfig(httpCfg),
 		WithActionHandler(cfg.HTTP.ActionHandler),
 		WithEventHandler(cfg.HTTP.EventHandler),
+		WithHTTPSessionRegistry(cfg.HTTP.Sessions),
 	)
 
 	wsCfg := WSConfig{
@@ -73,6 +78,7 @@ func NewUnifiedServer(cfg UnifiedConfig) *UnifiedServer {
 	wsSrv := NewWebSocketServer(
 		WithWSConfig(wsCfg),
 		WithWSActionHandler(cfg.WS.ActionHandler),
+		WithWSSessionRegistry(cfg.WS.Sessions),
 	)
 
 	// 使用 combinedHandler 分发请求
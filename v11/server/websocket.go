@@ -51,6 +51,7 @@ type WebSocketServer struct {
 
 	cfg      WSConfig
 	handler  dispatcher.ActionRequestHandler
+	sessions *SessionRegistry
 	upgrader websocket.Upgrader
 
 	mu            sync.Mutex
@@ -124,6 +125,13 @@ func WithWSActionHandler(handler dispatcher.ActionRequestHandler) WebSocketServe
 	}
 }
 
+// WithWSSessionRegistry 设置会话注册表，握手携带 X-Self-ID 的连接在连接期间登记到注册表.
+func WithWSSessionRegistry(registry *SessionRegistry) WebSocketServerOption {
+	return func(s *WebSocketServer) {
+		s.sessions = registry
+	}
+}
+
 // NewWebSocketServer 创建 WebSocketServer，配置由 opts 提供. 若传入 CheckOrigin 为 nil，则允许任意来源.
 func NewWebSocketServer(opts ...WebSocketServerOption) *WebSocketServer {
 	server := &WebSocketServer{
@@ -229,6 +237,8 @@ func (s *WebSocketServer) handleAPI(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer s.trackSession(r)()
+
 	s.serveActionConn(r.Context(), &wsConn{conn: conn}, false)
 }
 
@@ -250,6 +260,8 @@ func (s *WebSocketServer) handleUniversal(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	defer s.trackSession(r)()
+
 	wsC := &wsConn{conn: conn}
 
 	s.mu.Lock()
@@ -283,6 +295,8 @@ func (s *WebSocketServer) handleEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	defer s.trackSession(r)()
+
 	wsC := &wsConn{conn: conn}
 
 	s.mu.Lock()
@@ -330,8 +344,27 @@ func (s *WebSocketServer) handleActionMessage(ctx context.Context, data []byte)
 	return wsinternal.HandleActionMessage(ctx, data, s.handler, ErrBadRequest)
 }
 
+// trackSession 将握手携带 X-Self-ID 的连接登记到会话注册表，返回连接结束时调用的注销函数.
+func (s *WebSocketServer) trackSession(r *http.Request) func() {
+	selfID, ok := parseSelfIDHeader(r)
+	if s.sessions == nil || !ok {
+		return func() {}
+	}
+
+	s.sessions.Connect(selfID, SessionTransportWebSocket, nil)
+
+	return func() {
+		s.sessions.Disconnect(selfID, SessionTransportWebSocket)
+	}
+}
+
 func (s *WebSocketServer) checkAccess(r *http.Request) *entity.ActionResponseEnvelope {
-	if s.cfg.AccessToken == "" {
+	return checkWSAccess(r, s.cfg.AccessToken)
+}
+
+// checkWSAccess 校验 WebSocket 握手请求中的访问令牌，支持 Authorization 头与 access_token 查询参数.
+func checkWSAccess(r *http.Request, accessToken string) *entity.ActionResponseEnvelope {
+	if accessToken == "" {
 		return nil
 	}
 
@@ -346,17 +379,17 @@ func (s *WebSocketServer) checkAccess(r *http.Request) *entity.ActionResponseEnv
 		return &entity.ActionResponseEnvelope{
 			ActionRawResponse: entity.ActionRawResponse{
 				Status:  entity.StatusFailed,
-				Retcode: 1401,
+				Retcode: entity.RetcodeUnauthorized,
 				Message: "missing access token",
 			},
 		}
 	}
 
-	if token != s.cfg.AccessToken {
+	if token != accessToken {
 		return &entity.ActionResponseEnvelope{
 			ActionRawResponse: entity.ActionRawResponse{
 				Status:  entity.StatusFailed,
-				Retcode: 1403,
+				Retcode: entity.RetcodeForbidden,
 				Message: "forbidden",
 			},
 		}
@@ -401,9 +434,18 @@ func (s *WebSocketServer) closeAllConns() {
 }
 
 func (s *WebSocketServer) writeHandshakeError(w http.ResponseWriter, env *entity.ActionResponseEnvelope) {
+	writeWSHandshakeError(w, env)
+}
+
+// writeWSHandshakeError 以 JSON 写出握手失败响应，HTTP 状态码由 retcode 决定.
+func writeWSHandshakeError(w http.ResponseWriter, env *entity.ActionResponseEnvelope) {
 	status := http.StatusUnauthorized
-	if env.Retcode == 1403 {
+
+	switch {
+	case env.Retcode == entity.RetcodeForbidden:
 		status = http.StatusForbidden
+	case env.Retcode == entity.RetcodeBadRequest:
+		status = http.StatusBadRequest
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -443,6 +443,13 @@ func TestBroadcastEventIntegration(t *testing.T) {
 	msg1 := readJSON[entity.PrivateMessageEvent](t, eventConn)
 	msg2 := readJSON[entity.PrivateMessageEvent](t, universalConn)
 
-	require.Equal(t, *testEvent, msg1)
-	require.Equal(t, *testEvent, msg2)
+	// 反序列化后的事件会携带原始字段，按 JSON 内容比较
+	want, err := json.Marshal(testEvent)
+	require.NoError(t, err)
+
+	for _, msg := range []entity.PrivateMessageEvent{msg1, msg2} {
+		got, err := json.Marshal(&msg)
+		require.NoError(t, err)
+		require.JSONEq(t, string(want), string(got))
+	}
 }